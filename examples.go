@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// -------------------- request examples (gen_examples) --------------------
+
+// exampleGenerator emits one "<proto>_examples.json" per proto, mapping each
+// "Service.Method" to a request object in protobuf JSON form whose fields all
+// hold their zero value, ready to be edited by hand.
+type exampleGenerator struct {
+	// fully-qualified name (".pkg.Msg") -> message, of every file of the request
+	messages map[string]*descriptorpb.DescriptorProto
+	// fully-qualified name -> enum, for the name of its first value
+	enums map[string]*descriptorpb.EnumDescriptorProto
+	// json_enum=number: enum fields hold the number of their first value
+	enumNumbers bool
+}
+
+func newExampleGenerator(req []*descriptorpb.FileDescriptorProto, enumNumbers bool) *exampleGenerator {
+	g := &exampleGenerator{
+		messages:    make(map[string]*descriptorpb.DescriptorProto),
+		enums:       make(map[string]*descriptorpb.EnumDescriptorProto),
+		enumNumbers: enumNumbers,
+	}
+	for _, fd := range req {
+		prefix := strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), ".")
+		for _, ed := range fd.GetEnumType() {
+			g.enums[prefix+"."+ed.GetName()] = ed
+		}
+		g.indexMessages(prefix, fd.GetMessageType())
+	}
+	return g
+}
+
+func (g *exampleGenerator) indexMessages(prefix string, mds []*descriptorpb.DescriptorProto) {
+	for _, md := range mds {
+		name := prefix + "." + md.GetName()
+		g.messages[name] = md
+		for _, ed := range md.GetEnumType() {
+			g.enums[name+"."+ed.GetName()] = ed
+		}
+		g.indexMessages(name, md.GetNestedType())
+	}
+}
+
+// generate renders the examples of the methods of fd, "" when it has no services.
+func (g *exampleGenerator) generate(fd *descriptorpb.FileDescriptorProto) (string, error) {
+	var doc exampleObject
+	for _, svc := range fd.GetService() {
+		for _, m := range svc.GetMethod() {
+			doc = append(doc, exampleField{svc.GetName() + "." + m.GetName(), g.messageExample(m.GetInputType(), nil)})
+		}
+	}
+	if doc == nil {
+		return "", nil
+	}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out) + "\n", nil
+}
+
+// messageExample fills every field of the message, only the first field of a
+// oneof. visiting holds the messages being expanded: a recursive field is left
+// out instead of nesting forever.
+func (g *exampleGenerator) messageExample(typeName string, visiting []string) interface{} {
+	if v, ok := wellKnownExample(typeName); ok {
+		return v
+	}
+	md := g.messages[typeName]
+	if md == nil || contains(visiting, typeName) {
+		return exampleObject{}
+	}
+	visiting = append(visiting, typeName)
+	obj := exampleObject{}
+	oneofs := make(map[int32]bool)
+	for _, f := range md.GetField() {
+		if f.OneofIndex != nil && !f.GetProto3Optional() {
+			if oneofs[f.GetOneofIndex()] {
+				continue
+			}
+			oneofs[f.GetOneofIndex()] = true
+		}
+		if (f.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || f.GetType() == descriptorpb.FieldDescriptorProto_TYPE_GROUP) &&
+			f.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED && contains(visiting, f.GetTypeName()) {
+			continue
+		}
+		obj = append(obj, exampleField{jsonName(f), g.fieldExample(f, visiting)})
+	}
+	return obj
+}
+
+func (g *exampleGenerator) fieldExample(f *descriptorpb.FieldDescriptorProto, visiting []string) interface{} {
+	if f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		if entry := g.messages[f.GetTypeName()]; entry != nil && entry.GetOptions().GetMapEntry() {
+			return exampleObject{}
+		}
+		return []interface{}{}
+	}
+	return g.valueExample(f, visiting)
+}
+
+// valueExample is the zero value of a single value of f in protobuf JSON.
+func (g *exampleGenerator) valueExample(f *descriptorpb.FieldDescriptorProto, visiting []string) interface{} {
+	switch f.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return ""
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return false
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		// protobuf JSON writes 64-bit integers as strings
+		return "0"
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		if ed := g.enums[f.GetTypeName()]; ed != nil && len(ed.GetValue()) > 0 {
+			if g.enumNumbers {
+				return ed.GetValue()[0].GetNumber()
+			}
+			return ed.GetValue()[0].GetName()
+		}
+		return 0
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptorpb.FieldDescriptorProto_TYPE_GROUP:
+		return g.messageExample(f.GetTypeName(), visiting)
+	default:
+		return 0
+	}
+}
+
+// wellKnownExample is the zero value of the well-known types that have a
+// special protobuf JSON form, see wellKnownSchema.
+func wellKnownExample(typeName string) (interface{}, bool) {
+	switch typeName {
+	case ".google.protobuf.Timestamp":
+		return "1970-01-01T00:00:00Z", true
+	case ".google.protobuf.Duration":
+		return "0s", true
+	case ".google.protobuf.FieldMask", ".google.protobuf.StringValue", ".google.protobuf.BytesValue":
+		return "", true
+	case ".google.protobuf.Struct", ".google.protobuf.Empty":
+		return exampleObject{}, true
+	case ".google.protobuf.ListValue":
+		return []interface{}{}, true
+	case ".google.protobuf.Value":
+		return nil, true
+	case ".google.protobuf.Any":
+		return exampleObject{{"@type", ""}}, true
+	case ".google.protobuf.BoolValue":
+		return false, true
+	case ".google.protobuf.DoubleValue", ".google.protobuf.FloatValue",
+		".google.protobuf.Int32Value", ".google.protobuf.UInt32Value":
+		return 0, true
+	case ".google.protobuf.Int64Value", ".google.protobuf.UInt64Value":
+		return "0", true
+	}
+	return nil, false
+}
+
+// exampleObject is a JSON object that keeps its fields in declaration order,
+// where a map would sort them.
+type exampleObject []exampleField
+
+type exampleField struct {
+	name  string
+	value interface{}
+}
+
+func (o exampleObject) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		name, err := json.Marshal(f.name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files under testdata")
+
+// pluginEnv makes the test binary act as the plugin, so the tests run it the
+// way protoc does: a CodeGeneratorRequest on stdin, the response on stdout
+// and the exit status telling failures apart.
+const pluginEnv = "WEBVIEWRPC_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(pluginEnv) == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// TestGolden runs the plugin on every testdata/<case>/request.json and
+// compares the files it generates with testdata/<case>/out, and what it
+// prints to stderr with testdata/<case>/stderr.txt. After a deliberate
+// change of the output, rewrite them with
+//
+//	go test -run TestGolden -update
+func TestGolden(t *testing.T) {
+	requests, err := filepath.Glob(filepath.Join("testdata", "*", "request.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) == 0 {
+		t.Fatal("no test cases under testdata")
+	}
+	for _, path := range requests {
+		dir := filepath.Dir(path)
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			t.Parallel()
+			files, stderr := runPlugin(t, loadRequest(t, path))
+			if *update {
+				writeGolden(t, dir, files, stderr)
+				return
+			}
+			checkGolden(t, dir, files, stderr)
+		})
+	}
+}
+
+// loadRequest reads a CodeGeneratorRequest in protojson form. The custom
+// options of webviewrpc/options.proto are extensions the plugin has no Go
+// types for, so they are resolved from the descriptors in the request.
+func loadRequest(t testing.TB, path string) *pluginpb.CodeGeneratorRequest {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := new(pluginpb.CodeGeneratorRequest)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, req); err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: req.GetProtoFile()})
+	if err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	types := new(protoregistry.Types)
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		exts := fd.Extensions()
+		for i := 0; i < exts.Len(); i++ {
+			types.RegisterExtension(dynamicpb.NewExtensionType(exts.Get(i)))
+		}
+		return true
+	})
+	req = new(pluginpb.CodeGeneratorRequest)
+	if err := (protojson.UnmarshalOptions{Resolver: types}).Unmarshal(data, req); err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	return req
+}
+
+// runPlugin runs the plugin on req and returns the generated files by name
+// and its stderr, which ends with the exit status when it failed.
+func runPlugin(t testing.TB, req *pluginpb.CodeGeneratorRequest) (map[string]string, string) {
+	t.Helper()
+	in, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), pluginEnv+"=1")
+	cmd.Stdin = bytes.NewReader(in)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			t.Fatal(err)
+		}
+		fmt.Fprintln(&stderr, exitErr)
+		return nil, stderr.String()
+	}
+	resp := new(pluginpb.CodeGeneratorResponse)
+	if err := proto.Unmarshal(stdout.Bytes(), resp); err != nil {
+		t.Fatalf("invalid CodeGeneratorResponse: %v", err)
+	}
+	if resp.Error != nil {
+		fmt.Fprintf(&stderr, "response error: %s\n", resp.GetError())
+	}
+	files := make(map[string]string)
+	for _, f := range resp.GetFile() {
+		files[f.GetName()] = f.GetContent()
+	}
+	return files, stderr.String()
+}
+
+func writeGolden(t *testing.T, dir string, files map[string]string, stderr string) {
+	out := filepath.Join(dir, "out")
+	if err := os.RemoveAll(out); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(out, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stderrPath := filepath.Join(dir, "stderr.txt")
+	if stderr == "" {
+		if err := os.Remove(stderrPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			t.Fatal(err)
+		}
+		return
+	}
+	if err := os.WriteFile(stderrPath, []byte(stderr), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkGolden(t *testing.T, dir string, files map[string]string, stderr string) {
+	want := make(map[string]string)
+	out := filepath.Join(dir, "out")
+	err := filepath.WalkDir(out, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(out, path)
+		want[filepath.ToSlash(name)] = string(content)
+		return err
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(want)+len(files))
+	for name := range want {
+		names = append(names, name)
+	}
+	for name := range files {
+		if _, ok := want[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		got, generated := files[name]
+		golden, ok := want[name]
+		switch {
+		case !generated:
+			t.Errorf("%s was not generated", name)
+		case !ok:
+			t.Errorf("%s was generated but has no golden file", name)
+		case got != golden:
+			t.Errorf("%s differs from its golden file: %s", name, firstDiff(golden, got))
+		}
+	}
+	wantStderr, err := os.ReadFile(filepath.Join(dir, "stderr.txt"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		t.Fatal(err)
+	}
+	if stderr != string(wantStderr) {
+		t.Errorf("stderr differs from stderr.txt: %s", firstDiff(string(wantStderr), stderr))
+	}
+	if t.Failed() {
+		t.Log("run go test -run TestGolden -update if the change is intended")
+	}
+}
+
+// firstDiff describes the first line where got differs from want.
+func firstDiff(want, got string) string {
+	wantLines := strings.SplitAfter(want, "\n")
+	gotLines := strings.SplitAfter(got, "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d\nwant: %q\ngot:  %q", i+1, w, g)
+		}
+	}
+	return "no difference"
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// -------------------- JSON Schema (gen_json_schema) --------------------
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaGenerator emits one "<proto>.schema.json" per proto, holding every
+// message and enum of the file under "$defs" keyed by fully-qualified name.
+type jsonSchemaGenerator struct {
+	// fully-qualified type name (".pkg.Msg") -> file declaring it
+	typeFiles map[string]string
+	// files being generated, other files have no schema to reference
+	generated map[string]bool
+	// fully-qualified name -> map entry descriptor, for map fields
+	mapEntries map[string]*descriptorpb.DescriptorProto
+	// json_enum=number: enums are described by their numbers, not names
+	enumNumbers bool
+}
+
+func newJSONSchemaGenerator(req []*descriptorpb.FileDescriptorProto, filesToGenerate []string, enumNumbers bool) *jsonSchemaGenerator {
+	g := &jsonSchemaGenerator{
+		typeFiles:   make(map[string]string),
+		generated:   make(map[string]bool),
+		mapEntries:  make(map[string]*descriptorpb.DescriptorProto),
+		enumNumbers: enumNumbers,
+	}
+	for _, f := range filesToGenerate {
+		g.generated[f] = true
+	}
+	for _, fd := range req {
+		prefix := strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), ".")
+		for _, ed := range fd.GetEnumType() {
+			g.typeFiles[prefix+"."+ed.GetName()] = fd.GetName()
+		}
+		g.indexMessages(fd.GetName(), prefix, fd.GetMessageType())
+	}
+	return g
+}
+
+func (g *jsonSchemaGenerator) indexMessages(file, prefix string, mds []*descriptorpb.DescriptorProto) {
+	for _, md := range mds {
+		name := prefix + "." + md.GetName()
+		g.typeFiles[name] = file
+		if md.GetOptions().GetMapEntry() {
+			g.mapEntries[name] = md
+		}
+		for _, ed := range md.GetEnumType() {
+			g.typeFiles[name+"."+ed.GetName()] = file
+		}
+		g.indexMessages(file, name, md.GetNestedType())
+	}
+}
+
+// generate renders the schema document of fd.
+func (g *jsonSchemaGenerator) generate(fd *descriptorpb.FileDescriptorProto) (string, error) {
+	defs := make(map[string]interface{})
+	prefix := strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), ".")
+	for _, ed := range fd.GetEnumType() {
+		defs[defName(prefix+"."+ed.GetName())] = g.enumSchema(ed)
+	}
+	g.addMessageDefs(fd, prefix, fd.GetMessageType(), defs)
+
+	doc := map[string]interface{}{
+		"$schema": jsonSchemaDialect,
+		"title":   fd.GetName(),
+		"$defs":   defs,
+	}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out) + "\n", nil
+}
+
+func (g *jsonSchemaGenerator) addMessageDefs(fd *descriptorpb.FileDescriptorProto, prefix string, mds []*descriptorpb.DescriptorProto, defs map[string]interface{}) {
+	for _, md := range mds {
+		name := prefix + "." + md.GetName()
+		for _, ed := range md.GetEnumType() {
+			defs[defName(name+"."+ed.GetName())] = g.enumSchema(ed)
+		}
+		g.addMessageDefs(fd, name, md.GetNestedType(), defs)
+		if md.GetOptions().GetMapEntry() {
+			continue // inlined into the map field as additionalProperties
+		}
+
+		props := make(map[string]interface{})
+		for _, f := range md.GetField() {
+			schema := g.fieldSchema(fd, f)
+			if s, ok := schema.(map[string]interface{}); ok && f.GetOptions().GetDeprecated() {
+				s["deprecated"] = true
+			}
+			props[jsonName(f)] = schema
+		}
+		defs[defName(name)] = map[string]interface{}{
+			"type":       "object",
+			"title":      md.GetName(),
+			"properties": props,
+		}
+	}
+}
+
+func (g *jsonSchemaGenerator) fieldSchema(fd *descriptorpb.FileDescriptorProto, f *descriptorpb.FieldDescriptorProto) interface{} {
+	if entry, ok := g.mapEntries[f.GetTypeName()]; ok && f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		// map<K, V>: JSON object keys are always strings
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": g.valueSchema(fd, entry.GetField()[1]),
+		}
+	}
+	if f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return map[string]interface{}{
+			"type":  "array",
+			"items": g.valueSchema(fd, f),
+		}
+	}
+	return g.valueSchema(fd, f)
+}
+
+// valueSchema maps a single value of f following the protobuf JSON mapping.
+func (g *jsonSchemaGenerator) valueSchema(fd *descriptorpb.FileDescriptorProto, f *descriptorpb.FieldDescriptorProto) interface{} {
+	switch f.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		return map[string]interface{}{"type": "string"}
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return map[string]interface{}{"type": "boolean"}
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		return map[string]interface{}{"type": "number"}
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		// protobuf JSON writes 64-bit integers as strings and accepts both
+		return map[string]interface{}{"type": []string{"string", "integer"}, "pattern": "^-?[0-9]+$"}
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptorpb.FieldDescriptorProto_TYPE_GROUP,
+		descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		return g.refSchema(fd, f.GetTypeName())
+	default:
+		return map[string]interface{}{"type": "integer"}
+	}
+}
+
+// refSchema references a message/enum: locally, in the schema of another
+// generated proto, or as an unconstrained value when no schema exists for it.
+func (g *jsonSchemaGenerator) refSchema(fd *descriptorpb.FileDescriptorProto, typeName string) interface{} {
+	if s := wellKnownSchema(typeName); s != nil {
+		return s
+	}
+	file, ok := g.typeFiles[typeName]
+	switch {
+	case ok && file == fd.GetName():
+		return map[string]interface{}{"$ref": "#/$defs/" + defName(typeName)}
+	case ok && g.generated[file]:
+		return map[string]interface{}{"$ref": schemaRefPath(fd.GetName(), file) + "#/$defs/" + defName(typeName)}
+	default:
+		return map[string]interface{}{"description": strings.TrimPrefix(typeName, ".")}
+	}
+}
+
+// wellKnownSchema maps the well-known types that have a special protobuf JSON
+// form, nil for other types.
+func wellKnownSchema(typeName string) interface{} {
+	switch typeName {
+	case ".google.protobuf.Timestamp":
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case ".google.protobuf.Duration":
+		return map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+(\\.[0-9]{1,9})?s$"}
+	case ".google.protobuf.FieldMask":
+		return map[string]interface{}{"type": "string"}
+	case ".google.protobuf.Struct", ".google.protobuf.Empty":
+		return map[string]interface{}{"type": "object"}
+	case ".google.protobuf.ListValue":
+		return map[string]interface{}{"type": "array"}
+	case ".google.protobuf.Value":
+		return map[string]interface{}{}
+	case ".google.protobuf.Any":
+		return map[string]interface{}{"type": "object", "required": []string{"@type"}}
+	case ".google.protobuf.StringValue":
+		return map[string]interface{}{"type": []string{"string", "null"}}
+	case ".google.protobuf.BytesValue":
+		return map[string]interface{}{"type": []string{"string", "null"}, "contentEncoding": "base64"}
+	case ".google.protobuf.BoolValue":
+		return map[string]interface{}{"type": []string{"boolean", "null"}}
+	case ".google.protobuf.DoubleValue", ".google.protobuf.FloatValue":
+		return map[string]interface{}{"type": []string{"number", "null"}}
+	case ".google.protobuf.Int32Value", ".google.protobuf.UInt32Value":
+		return map[string]interface{}{"type": []string{"integer", "null"}}
+	case ".google.protobuf.Int64Value", ".google.protobuf.UInt64Value":
+		return map[string]interface{}{"type": []string{"string", "integer", "null"}, "pattern": "^-?[0-9]+$"}
+	}
+	return nil
+}
+
+// enumSchema lists the values of ed by name, or by number with json_enum=number.
+func (g *jsonSchemaGenerator) enumSchema(ed *descriptorpb.EnumDescriptorProto) interface{} {
+	if g.enumNumbers {
+		var numbers []int32
+		seen := make(map[int32]bool) // aliases (allow_alias) share a number
+		for _, v := range ed.GetValue() {
+			if !seen[v.GetNumber()] {
+				seen[v.GetNumber()] = true
+				numbers = append(numbers, v.GetNumber())
+			}
+		}
+		return map[string]interface{}{
+			"type":  "integer",
+			"title": ed.GetName(),
+			"enum":  numbers,
+		}
+	}
+	var names []string
+	for _, v := range ed.GetValue() {
+		names = append(names, v.GetName())
+	}
+	return map[string]interface{}{
+		"type":  "string",
+		"title": ed.GetName(),
+		"enum":  names,
+	}
+}
+
+// defName is the "$defs" key of a fully-qualified type, e.g. ".pkg.Msg" -> "pkg.Msg".
+func defName(fullName string) string {
+	return strings.TrimPrefix(fullName, ".")
+}
+
+// schemaRefPath is the relative path from the schema of proto "from" to the
+// schema of proto "to".
+func schemaRefPath(from, to string) string {
+	target := strings.TrimSuffix(to, filepath.Ext(to)) + ".schema.json"
+	rel, err := filepath.Rel(filepath.Dir(from), target)
+	if err != nil {
+		return target
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// -------------------- sensitive fields (gen_log_hook) --------------------
+
+// sensitiveMessage is a message whose fields, or those of the messages below
+// it, are marked (webviewrpc.sensitive): what a client redacts in it before
+// passing a call to its log hook.
+type sensitiveMessage struct {
+	FullName string           // e.g. "helloworld.User"
+	Fields   []sensitiveField // marked sensitive themselves, redacted as a whole
+	Messages []sensitiveField // message fields holding sensitive fields, TypeName set
+	Maps     []sensitiveField // map fields with such message values, TypeName set
+}
+
+type sensitiveField struct {
+	Name     string // proto name, e.g. "email"
+	JsonName string
+	TypeName string // full name of the message type, e.g. "helloworld.Profile"
+}
+
+// sensitiveIndex knows the messages of the request holding sensitive fields.
+type sensitiveIndex struct {
+	// fully-qualified name (".pkg.Msg") -> message, of every file of the request
+	messages map[string]*descriptorpb.DescriptorProto
+	// fully-qualified names of the messages with sensitive fields, directly or
+	// in a message, repeated or map field
+	sensitive map[string]bool
+}
+
+func newSensitiveIndex(req []*descriptorpb.FileDescriptorProto) *sensitiveIndex {
+	x := &sensitiveIndex{
+		messages:  make(map[string]*descriptorpb.DescriptorProto),
+		sensitive: make(map[string]bool),
+	}
+	var index func(prefix string, mds []*descriptorpb.DescriptorProto)
+	index = func(prefix string, mds []*descriptorpb.DescriptorProto) {
+		for _, md := range mds {
+			name := prefix + "." + md.GetName()
+			x.messages[name] = md
+			index(name, md.GetNestedType())
+		}
+	}
+	for _, fd := range req {
+		index(strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), "."), fd.GetMessageType())
+	}
+	for name, md := range x.messages {
+		for _, f := range md.GetField() {
+			if isSensitive(f) {
+				x.sensitive[name] = true
+			}
+		}
+	}
+	// messages holding such messages, until no more are found: fields may
+	// refer to each other in cycles
+	for changed := true; changed; {
+		changed = false
+		for name, md := range x.messages {
+			if x.sensitive[name] {
+				continue
+			}
+			for _, f := range md.GetField() {
+				if t := x.valueType(f); t != "" && x.sensitive[t] {
+					x.sensitive[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return x
+}
+
+// isSensitive reports whether f is marked (webviewrpc.sensitive) = true.
+func isSensitive(f *descriptorpb.FieldDescriptorProto) bool {
+	v, ok := readVarintOption(f.GetOptions(), optSensitive)
+	return ok && v != 0
+}
+
+// valueType is the fully-qualified message type of the values of f: its own
+// type, or the value type of a map field; "" for other fields.
+func (x *sensitiveIndex) valueType(f *descriptorpb.FieldDescriptorProto) string {
+	if f.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE && f.GetType() != descriptorpb.FieldDescriptorProto_TYPE_GROUP {
+		return ""
+	}
+	if entry := x.messages[f.GetTypeName()]; entry.GetOptions().GetMapEntry() {
+		for _, v := range entry.GetField() {
+			if v.GetNumber() == 2 && v.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+				return v.GetTypeName()
+			}
+		}
+		return ""
+	}
+	return f.GetTypeName()
+}
+
+// forMethods returns the messages with sensitive fields that the requests and
+// responses of methods hold, in the order they are reached.
+func (x *sensitiveIndex) forMethods(methods []methodInfo) []sensitiveMessage {
+	var out []sensitiveMessage
+	seen := make(map[string]bool)
+	var visit func(name string)
+	visit = func(name string) {
+		if seen[name] || !x.sensitive[name] {
+			return
+		}
+		seen[name] = true
+		md := x.messages[name]
+		msg := sensitiveMessage{FullName: strings.TrimPrefix(name, ".")}
+		var below []string
+		for _, f := range md.GetField() {
+			field := sensitiveField{Name: f.GetName(), JsonName: jsonName(f)}
+			if isSensitive(f) {
+				msg.Fields = append(msg.Fields, field)
+				continue
+			}
+			t := x.valueType(f)
+			if t == "" || !x.sensitive[t] {
+				continue
+			}
+			field.TypeName = strings.TrimPrefix(t, ".")
+			if t == f.GetTypeName() {
+				msg.Messages = append(msg.Messages, field)
+			} else {
+				msg.Maps = append(msg.Maps, field)
+			}
+			below = append(below, t)
+		}
+		out = append(out, msg)
+		for _, t := range below {
+			visit(t)
+		}
+	}
+	for _, m := range methods {
+		visit("." + m.ProtoInputType)
+		visit("." + m.ProtoOutputType)
+	}
+	return out
+}
@@ -1,14 +1,23 @@
 package main
 
 import (
+	"bytes"
 	_ "embed"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"unicode"
 
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
@@ -34,6 +43,72 @@ var tsClientTemplateStr string
 //go:embed templates/ts_server.tmpl
 var tsServerTemplateStr string
 
+//go:embed templates/csharp_runtime.tmpl
+var csharpRuntimeTemplateStr string
+
+//go:embed templates/js_runtime.tmpl
+var jsRuntimeTemplateStr string
+
+//go:embed templates/ts_runtime.tmpl
+var tsRuntimeTemplateStr string
+
+//go:embed templates/csharp_factory.tmpl
+var csharpFactoryTemplateStr string
+
+//go:embed templates/js_factory.tmpl
+var jsFactoryTemplateStr string
+
+//go:embed templates/ts_factory.tmpl
+var tsFactoryTemplateStr string
+
+//go:embed templates/csharp_facade.tmpl
+var csharpFacadeTemplateStr string
+
+//go:embed templates/csharp_di.tmpl
+var csharpDiTemplateStr string
+
+//go:embed templates/js_facade.tmpl
+var jsFacadeTemplateStr string
+
+//go:embed templates/ts_facade.tmpl
+var tsFacadeTemplateStr string
+
+//go:embed templates/csharp_file.tmpl
+var csharpFileTemplateStr string
+
+//go:embed templates/js_file.tmpl
+var jsFileTemplateStr string
+
+//go:embed templates/csharp_field_numbers.tmpl
+var csharpFieldNumbersTemplateStr string
+
+//go:embed templates/js_field_numbers.tmpl
+var jsFieldNumbersTemplateStr string
+
+//go:embed templates/csharp_message_registry.tmpl
+var csharpMessageRegistryTemplateStr string
+
+//go:embed templates/js_message_registry.tmpl
+var jsMessageRegistryTemplateStr string
+
+//go:embed templates/ts_message_registry.tmpl
+var tsMessageRegistryTemplateStr string
+
+//go:embed templates/csharp_enum_names.tmpl
+var csharpEnumNamesTemplateStr string
+
+//go:embed templates/csharp_tests.tmpl
+var csharpTestsTemplateStr string
+
+//go:embed templates/js_tests.tmpl
+var jsTestsTemplateStr string
+
+//go:embed templates/markdown.tmpl
+var markdownTemplateStr string
+
+//go:embed templates/php_client.tmpl
+var phpClientTemplateStr string
+
 var (
 	csharpClientTmpl *template.Template
 	csharpServerTmpl *template.Template
@@ -41,32 +116,558 @@ var (
 	jsServerTmpl     *template.Template
 	tsClientTmpl     *template.Template
 	tsServerTmpl     *template.Template
+	phpClientTmpl    *template.Template
+
+	// support code shared by all generated files of a language
+	csharpRuntimeTmpl *template.Template
+	jsRuntimeTmpl     *template.Template
+	tsRuntimeTmpl     *template.Template
+
+	// per-proto client factories (gen_client_factory)
+	csharpFactoryTmpl *template.Template
+	jsFactoryTmpl     *template.Template
+	tsFactoryTmpl     *template.Template
+
+	// facade over the clients of every proto of the run (gen_facade)
+	csharpFacadeTmpl *template.Template
+	jsFacadeTmpl     *template.Template
+	tsFacadeTmpl     *template.Template
+
+	// IServiceCollection extension registering every C# client (cs_gen_di_extensions)
+	csharpDiTmpl *template.Template
+
+	// wrappers for single_file output
+	csharpFileTmpl *template.Template
+	jsFileTmpl     *template.Template
+
+	// per-proto field number constants (gen_field_numbers), the JS one serves TS too
+	csharpFieldNumbersTmpl *template.Template
+	jsFieldNumbersTmpl     *template.Template
+
+	// per-proto message factories by full name (gen_message_registry)
+	csharpMessageRegistryTmpl *template.Template
+	jsMessageRegistryTmpl     *template.Template
+	tsMessageRegistryTmpl     *template.Template
+
+	// per-service client test scaffolds (gen_tests): xUnit and Jest
+	csharpTestsTmpl *template.Template
+	jsTestsTmpl     *template.Template
+
+	// per-proto ToDisplayString extensions of the C# enums
+	csharpEnumNamesTmpl *template.Template
+
+	// per-service API docs (gen_markdown)
+	markdownTmpl *template.Template
 )
 
+var templateFuncs = template.FuncMap{
+	"join":         strings.Join,
+	"mdCell":       markdownCell,
+	"docLines":     docLines,
+	"methodDoc":    methodDoc,
+	"xmlDoc":       xmlDocEscaper.Replace,
+	"jsDoc":        jsDocEscape,
+	"toPascalCase": toPascalCase,
+	"endpoint":     endpointExpr,
+}
+
 func init() {
-	csharpClientTmpl = template.Must(template.New("csharp_client").Parse(csharpClientTemplateStr))
-	csharpServerTmpl = template.Must(template.New("csharp_server").Parse(csharpServerTemplateStr))
-	jsClientTmpl = template.Must(template.New("js_client").Parse(jsClientTemplateStr))
-	jsServerTmpl = template.Must(template.New("js_server").Parse(jsServerTemplateStr))
-	tsClientTmpl = template.Must(template.New("ts_client").Parse(tsClientTemplateStr))
-	tsServerTmpl = template.Must(template.New("ts_server").Parse(tsServerTemplateStr))
+	csharpClientTmpl = template.Must(template.New("csharp_client").Funcs(templateFuncs).Parse(csharpClientTemplateStr))
+	csharpServerTmpl = template.Must(template.New("csharp_server").Funcs(templateFuncs).Parse(csharpServerTemplateStr))
+	jsClientTmpl = template.Must(template.New("js_client").Funcs(templateFuncs).Parse(jsClientTemplateStr))
+	jsServerTmpl = template.Must(template.New("js_server").Funcs(templateFuncs).Parse(jsServerTemplateStr))
+	tsClientTmpl = template.Must(template.New("ts_client").Funcs(templateFuncs).Parse(tsClientTemplateStr))
+	tsServerTmpl = template.Must(template.New("ts_server").Funcs(templateFuncs).Parse(tsServerTemplateStr))
+	phpClientTmpl = template.Must(template.New("php_client").Funcs(templateFuncs).Parse(phpClientTemplateStr))
+	csharpRuntimeTmpl = template.Must(template.New("csharp_runtime").Funcs(templateFuncs).Parse(csharpRuntimeTemplateStr))
+	jsRuntimeTmpl = template.Must(template.New("js_runtime").Funcs(templateFuncs).Parse(jsRuntimeTemplateStr))
+	tsRuntimeTmpl = template.Must(template.New("ts_runtime").Funcs(templateFuncs).Parse(tsRuntimeTemplateStr))
+	csharpFactoryTmpl = template.Must(template.New("csharp_factory").Funcs(templateFuncs).Parse(csharpFactoryTemplateStr))
+	jsFactoryTmpl = template.Must(template.New("js_factory").Funcs(templateFuncs).Parse(jsFactoryTemplateStr))
+	tsFactoryTmpl = template.Must(template.New("ts_factory").Funcs(templateFuncs).Parse(tsFactoryTemplateStr))
+	csharpFacadeTmpl = template.Must(template.New("csharp_facade").Funcs(templateFuncs).Parse(csharpFacadeTemplateStr))
+	jsFacadeTmpl = template.Must(template.New("js_facade").Funcs(templateFuncs).Parse(jsFacadeTemplateStr))
+	tsFacadeTmpl = template.Must(template.New("ts_facade").Funcs(templateFuncs).Parse(tsFacadeTemplateStr))
+	csharpDiTmpl = template.Must(template.New("csharp_di").Funcs(templateFuncs).Parse(csharpDiTemplateStr))
+	csharpFileTmpl = template.Must(template.New("csharp_file").Funcs(templateFuncs).Parse(csharpFileTemplateStr))
+	jsFileTmpl = template.Must(template.New("js_file").Funcs(templateFuncs).Parse(jsFileTemplateStr))
+	csharpFieldNumbersTmpl = template.Must(template.New("csharp_field_numbers").Funcs(templateFuncs).Parse(csharpFieldNumbersTemplateStr))
+	jsFieldNumbersTmpl = template.Must(template.New("js_field_numbers").Funcs(templateFuncs).Parse(jsFieldNumbersTemplateStr))
+	csharpMessageRegistryTmpl = template.Must(template.New("csharp_message_registry").Funcs(templateFuncs).Parse(csharpMessageRegistryTemplateStr))
+	jsMessageRegistryTmpl = template.Must(template.New("js_message_registry").Funcs(templateFuncs).Parse(jsMessageRegistryTemplateStr))
+	tsMessageRegistryTmpl = template.Must(template.New("ts_message_registry").Funcs(templateFuncs).Parse(tsMessageRegistryTemplateStr))
+	csharpEnumNamesTmpl = template.Must(template.New("csharp_enum_names").Funcs(templateFuncs).Parse(csharpEnumNamesTemplateStr))
+	csharpTestsTmpl = template.Must(template.New("csharp_tests").Funcs(templateFuncs).Parse(csharpTestsTemplateStr))
+	jsTestsTmpl = template.Must(template.New("js_tests").Funcs(templateFuncs).Parse(jsTestsTemplateStr))
+	markdownTmpl = template.Must(template.New("markdown").Funcs(templateFuncs).Parse(markdownTemplateStr))
 }
 
 // -------------------- Struct & Methods --------------------
 
 type methodInfo struct {
-	MethodName string
-	InputType  string
-	OutputType string
+	MethodName string // proto name, used on the wire
+
+	// C# identifier of the method: MethodName unless it collides with the
+	// generated class, see method_name_collision
+	CsMethodName string
+	InputType    string
+	OutputType   string
+
+	// JS/TS identifiers of the types, see js_ns_sep
+	JsInputType  string
+	JsOutputType string
+
+	// PHP classes of the types, relative to serviceInfo.PhpNamespace
+	PhpInputType  string
+	PhpOutputType string
+
+	// proto full names of the types, without the leading dot
+	ProtoInputType  string
+	ProtoOutputType string
+
+	// what client methods resolve to, the output type unless wrapped (gen_trace)
+	CsResultType string
+	JsResultType string
+
+	ClientStreaming bool
+	// gen_streaming: clients call a server-streaming method as a stream,
+	// otherwise like a unary method; see ProtoServerStreaming for the rpc
+	ServerStreaming      bool
+	ProtoServerStreaming bool
+
+	// from "option idempotency_level"
+	NoSideEffects bool
+	Idempotent    bool // IDEMPOTENT or NO_SIDE_EFFECTS
+
+	Cached  bool // gen_cache and NoSideEffects
+	Deduped bool // gen_dedupe and Idempotent, unary only
+
+	// gen_optimistic: unary methods with side effects get a <Method>Optimistic
+	// overload returning an optimistic response, reconciled on the real one
+	Optimistic bool
+
+	// client call timeout: (webviewrpc.timeout_ms), else default_timeout_ms; 0 = none
+	TimeoutMs int
+
+	// (webviewrpc.require_auth): servers call CheckAuth before the method
+	RequireAuth bool
+
+	// cs_method_prologue / cs_method_epilogue rendered for this method, see
+	// renderMethodSnippet
+	CsPrologue string
+	CsEpilogue string
+
+	Comment string // leading comment of the rpc in the .proto
+}
+
+type fieldInfo struct {
+	Name     string
+	JsonName string
+	Number   int32
+	TsType   string
+	JsZero   string // JS literal of the default value, "" for fields unset by default
+
+	// proto full name of message and enum fields, e.g. "shop.Order.Status"; "" for scalars
+	TypeName string
+
+	IsMessage   bool // message or group field; TypeName names an enum otherwise
+	HasPresence bool // set and unset are told apart, see hasPresence
+	Deprecated  bool // [deprecated = true]
+}
+
+type oneofInfo struct {
+	Name     string
+	JsonName string // lowerCamelCase, the property of the union on the TS message
+	TypeName string // e.g. "ShapeKind" for oneof "kind" in message "Shape"
+	Fields   []fieldInfo
+}
+
+// tsInterfaceInfo is a type declared by a TS client: an open interface with
+// a property per oneof, typed as its discriminated union. Enum is set for
+// enums of other protos, declared as their number.
+type tsInterfaceInfo struct {
+	Name   string
+	Oneofs []oneofInfo
+	Enum   bool
+}
+
+type messageInfo struct {
+	Name     string // within the proto, e.g. "HelloRequest" or "Outer.Inner" for nested messages
+	FullName string // e.g. "helloworld.HelloRequest"
+	JsName   string
+	CsName   string // C# type, e.g. "Outer.Types.Inner"
+
+	// names of the constants generated per message: Name with '_' for '.' in
+	// C#, and in JS/TS too unless js_ns_sep already flattens JsName
+	CsFlatName string
+	JsFlatName string
+
+	Fields []fieldInfo
+	Oneofs []oneofInfo
+}
+
+type enumValueInfo struct {
+	Name   string
+	Number int32
+}
+
+type enumInfo struct {
+	Name       string
+	FullName   string // e.g. "shop.Order.Status"
+	JsName     string
+	CsName     string // e.g. "Outer.Types.Kind" for enum Kind nested in message Outer
+	Values     []enumValueInfo
+	AllowAlias bool // several names share a number ("option allow_alias = true")
+
+	// Values without the aliases, the first name of each number names it
+	UniqueValues []enumValueInfo
 }
 
 type serviceInfo struct {
-	CsharpNamespace string
+	CsharpNamespace string // "" with cs_no_namespace
+	PhpNamespace    string // namespace of the PHP client, "" for the global one
 	ServiceName     string
 	Methods         []methodInfo
+	Comment         string // leading comment of the service in the .proto
+
+	// rename_map: obsolete client methods under the old names of renamed rpcs
+	RenameShims []renameShim
+
+	HasServerStreaming bool
+	HasCachedMethods   bool
+	HasDedupedMethods  bool
+	HasOptimistic      bool // a method has a gen_optimistic overload
+	HasTimeouts        bool
+	HasAuthMethods     bool // a method sets (webviewrpc.require_auth)
+	CacheTtlMs         int
 
-	AllMessages   []string
+	// max_payload_bytes: clients refuse larger serialized requests; 0 = no limit
+	MaxPayloadBytes int
+
+	// max_concurrent: unary calls in flight per client, later ones wait in a
+	// FIFO queue; 0 = no limit
+	MaxConcurrent int
+
+	AllMessages   []string // top-level messages of the proto, in declaration order
+	Messages      []messageInfo
+	HasOneofs     bool
+	Enums         []enumInfo
+	GenEnumNames  bool // C# ToDisplayString() and TS <Enum>Name() of the enums
 	ProtoBaseName string
+
+	// distinct request/response types and the JS/TS encode/decode functions they need
+	TypeNames []string
+	// TS client declarations: TsInterfaces for TypeNames, TsOneofInterfaces
+	// for the other messages and types the oneof unions refer to
+	TsInterfaces      []tsInterfaceInfo
+	TsOneofInterfaces []tsInterfaceInfo
+	ClientImports     []string
+	ServerImports     []string
+
+	// transport send method names rendered at the client call site
+	CsTransportMethod string
+	JsTransportMethod string
+
+	// namespace of the C# protobuf runtime (IMessage, ByteString)
+	CsProtobufNs string
+
+	// gen_raw_overload: byte[]/Uint8Array overloads, C# sends them through CsRawTransportMethod
+	GenRawOverload       bool
+	CsRawTransportMethod string
+
+	GenTrace    bool
+	GenMetadata bool
+
+	TsGenInterface bool // ts_gen_interface: I<Service>Client implemented by the TS client
+
+	// gen_connection_events: onOpen/onClose/onError of the JS client
+	GenConnectionEvents bool
+
+	CsGenSyncWrapper bool // cs_gen_sync_wrapper: blocking <Method>Sync wrappers
+	CsArgChecks      bool // cs_arg_checks: ArgumentNullException for a null request
+	CsStreamCallback bool // cs_stream_style=callback: callbacks instead of IAsyncEnumerable
+
+	GenBatch bool // gen_batch: JS client batch() and the C# server's $batch handler
+
+	GenStreamManager bool // gen_stream_manager: JS/TS <Service>StreamManager
+
+	// gen_cancel: JS/TS clients track unary calls by envelope request id for cancel()
+	GenCancel bool
+
+	// gen_sign: clients sign the payload of unary envelopes with an optional
+	// RpcSigner, servers check it with an overridable verifySignature
+	GenSign bool
+
+	// schema_version: clients stamp SchemaVersion in their request envelopes
+	// and check it in the response envelopes, warning or throwing on a
+	// mismatch (SchemaMismatch "warn" or "error"); servers stamp their responses
+	SchemaVersion  string
+	SchemaMismatch string
+
+	// compression=gzip: typed unary calls gzip the payload of their request
+	// envelope and decompress flagged responses; servers decompress flagged
+	// requests and compress the responses to them
+	GzipCompression bool
+
+	// gen_serializer: clients (de)serialize unary calls through an ISerializer /
+	// RpcSerializer, C# ones then send through CsRawTransportMethod
+	GenSerializer bool
+
+	// stream_fallback=poll: JS/TS subscribe() polls "<Method>$poll" every
+	// StreamPollIntervalMs instead of calling callServerStreamingMethod
+	StreamPoll           bool
+	StreamPollIntervalMs int
+
+	// gen_backpressure: JS/TS subscriptions get pause()/resume(), which send
+	// "<Service>.$flow" control frames
+	GenBackpressure bool
+
+	// gen_base_url: clients take a base URL and call the transport with the
+	// path "<base>/<ProtoServiceName>/<Method>" when it is not empty
+	GenBaseUrl bool
+
+	// gen_interceptors: unary client methods run through the interceptors
+	// added with addInterceptor / AddInterceptor before calling the transport
+	GenInterceptors bool
+
+	// gen_otel: typed unary client methods run inside a span of the tracer
+	// passed to the client constructor, named "<ProtoServiceName>/<Method>"
+	GenOtel bool
+
+	// gen_log_hook: typed unary client methods pass each call to the log hook
+	// given to the client constructor, with the fields of SensitiveMessages
+	// redacted
+	GenLogHook        bool
+	SensitiveMessages []sensitiveMessage
+
+	// gen_offline_queue: JS/TS clients send their typed unary calls through
+	// an OfflineQueue, persisted in the storage passed to the constructor,
+	// which holds them while the transport is offline
+	GenOfflineQueue bool
+
+	// ws_reconnect: JS/TS clients reconnect the WebSocket of the transport
+	// with rpcClient.reconnect() when it drops, up to WsReconnectMax attempts
+	// starting WsReconnectBackoffMs apart and doubling; calls wait meanwhile
+	WsReconnect          bool
+	WsReconnectMax       int
+	WsReconnectBackoffMs int
+
+	// gen_expose_transport: clients expose the transport they were given as a
+	// read-only Transport / transport
+	GenExposeTransport bool
+
+	// fully-qualified proto name, e.g. "helloworld.Greeter", or just the
+	// service name without a package; also rendered as a constant
+	ProtoServiceName string
+
+	// js_typedefs: request/response messages documented with @typedef in JS,
+	// with the messages and enums reachable through their fields
+	Typedefs     []messageInfo
+	TypedefEnums []enumInfo
+
+	// gen_envelope: unary requests and responses travel wrapped in an RpcCallEnvelope
+	GenEnvelope bool
+
+	// gen_reflection: the servers answer "<Service>.$reflect" with ReflectionJSON,
+	// the methods of the service with their proto request/response types
+	GenReflection  bool
+	ReflectionJSON string
+
+	// namespace of the message classes, imported when the services are
+	// generated elsewhere (cs_namespace, cs_no_namespace)
+	CsUsingNamespace string
+
+	CsAccess string // cs_access: modifier of the generated C# types
+
+	// import path of the JS/TS runtime file relative to the generated file, without extension
+	JsRuntimePath        string
+	ClientRuntimeImports []string
+	ServerRuntimeImports []string
+	// TS also imports the runtime types (Traced, RpcMetadata, RpcCallContext)
+	TsClientRuntimeImports []string
+	TsServerRuntimeImports []string
+}
+
+type factoryClient struct {
+	ServiceName string
+	ImportPath  string // JS/TS module of the client class, without extension
+}
+
+// factoryInfo is the template data of a per-proto client factory.
+type factoryInfo struct {
+	CsharpNamespace string
+	CsAccess        string
+	ProtoBaseName   string
+	Clients         []factoryClient
+}
+
+// facadeClient is a client of the run, as listed by the gen_facade facade and
+// the cs_gen_di_extensions registrations.
+type facadeClient struct {
+	ServiceName     string
+	CsClassName     string // e.g. "global::Helloworld.GreeterClient"
+	CsInterfaceName string // e.g. "global::Helloworld.IGreeterClient"
+	ImportPath      string // JS/TS module of the client class from the facade, without extension
+}
+
+// facadeInfo is the template data of the gen_facade facade of one language,
+// accumulated over every proto of the run.
+type facadeInfo struct {
+	CsAccess string
+	Clients  []facadeClient
+}
+
+// diInfo is the template data of the cs_gen_di_extensions registrations,
+// accumulated over every proto of the run.
+type diInfo struct {
+	CsAccess string
+	Lifetime string // Singleton, Scoped or Transient
+	Clients  []facadeClient
+}
+
+// testScaffoldInfo is the template data of a client test scaffold (gen_tests).
+type testScaffoldInfo struct {
+	serviceInfo
+
+	// JS modules of the client and of the codecs, relative to the test, without extension
+	ClientImportPath string
+	CodecImportPath  string
+	ResponseEncoders []string
+}
+
+// protoTypesInfo is the template data of the per-proto field number constants
+// and enum name lookups.
+type protoTypesInfo struct {
+	CsharpNamespace  string
+	CsAccess         string
+	CsUsingNamespace string
+	ProtoBaseName    string
+	ClassName        string // C# class of the enum extensions, name prefix of the message registry
+	CsProtobufNs     string
+	Messages         []messageInfo
+	Enums            []enumInfo
+}
+
+// runtimeInfo selects the support code emitted into the per-language runtime
+// file (WebViewRpcRuntime.cs, webviewrpc_runtime.js/.ts).
+type runtimeInfo struct {
+	GenTrace    bool
+	GenMetadata bool
+	GenBatch    bool // JS client and C# server only
+	GenEnvelope bool
+	GenCancel   bool // JS/TS only, implies GenEnvelope
+	GenSign     bool // implies GenEnvelope
+	HasTimeouts bool
+
+	GenSchemaVersion bool // schema_version, implies GenEnvelope
+	GzipCompression  bool // compression=gzip, implies GenEnvelope
+	GenInterceptors  bool // clients only
+	GenOtel          bool // clients only
+	GenLogHook       bool // clients only
+	WsReconnect      bool // JS/TS clients only
+	GenOfflineQueue  bool // JS/TS clients only
+
+	GenSerializer   bool // clients only
+	StreamPoll      bool // JS/TS clients of server-streaming methods
+	GenBackpressure bool // JS/TS clients of server-streaming methods
+	TsOneofs        bool // TS clients of protos with oneofs: assertNever
+
+	CsProtobufNs string
+	CsAccess     string
+}
+
+// needed reports whether lang ("cs", "js" or "ts") requires a runtime file.
+// C# timeouts use UniTask's own Timeout, only JS/TS need helpers for them.
+func (r runtimeInfo) needed(lang string) bool {
+	switch lang {
+	case "cs":
+		return r.GenTrace || r.GenMetadata || r.GenBatch || r.GenEnvelope || r.GenSerializer || r.GenInterceptors || r.GenOtel || r.GenLogHook
+	case "js":
+		return r.GenTrace || r.GenMetadata || r.GenBatch || r.GenEnvelope || r.GenSerializer || r.HasTimeouts || r.StreamPoll || r.GenBackpressure || r.GenInterceptors || r.GenOtel || r.GenLogHook || r.WsReconnect || r.GenOfflineQueue
+	}
+	return r.GenTrace || r.GenMetadata || r.GenEnvelope || r.GenSerializer || r.HasTimeouts || r.StreamPoll || r.GenBackpressure || r.GenInterceptors || r.GenOtel || r.GenLogHook || r.WsReconnect || r.GenOfflineQueue || r.TsOneofs
+}
+
+// reflectionMethod is one entry of serviceInfo.ReflectionJSON (gen_reflection).
+type reflectionMethod struct {
+	Method          string `json:"method"`
+	InputType       string `json:"inputType"`
+	OutputType      string `json:"outputType"`
+	ServerStreaming bool   `json:"serverStreaming"`
+}
+
+// generationReport is written to webviewrpc_report.json (gen_report).
+type generationReport struct {
+	Protos   []protoReport `json:"protos"`
+	Files    []string      `json:"files"`              // shared runtime files
+	Warnings []string      `json:"warnings,omitempty"` // raised after the protos were processed, e.g. by cs_format_cmd
+}
+
+type protoReport struct {
+	Proto    string   `json:"proto"`
+	Services []string `json:"services"`
+	Methods  int      `json:"methods"`
+	Targets  []string `json:"targets"`
+	Files    []string `json:"files"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type genTarget struct {
+	enabled  bool
+	tmpl     *template.Template
+	lang     string // "cs", "js", "ts" or "php"
+	role     string // "client" or "server"
+	fileName string // e.g. "%s_%sClient.cs" (proto base name, service name)
+}
+
+// singleFileInfo collects the sections of every service/role rendered for one
+// language of a proto, so single_file output emits imports and namespace once.
+type singleFileInfo struct {
+	CsharpNamespace string
+	ProtoBaseName   string
+	ServiceNames    []string
+	Imports         []string
+	Types           []string
+	Bodies          []string
+}
+
+func (sf *singleFileInfo) add(tmpl *template.Template, svc serviceInfo) error {
+	if !contains(sf.ServiceNames, svc.ServiceName) {
+		sf.ServiceNames = append(sf.ServiceNames, svc.ServiceName)
+	}
+
+	imports, err := renderSection(tmpl, "imports", svc)
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(imports, "\n") {
+		// section comments are emitted once by the wrapper
+		if !strings.HasPrefix(line, "//") {
+			sf.Imports = append(sf.Imports, line)
+		}
+	}
+	sf.Imports = mergeImportLines(sf.Imports)
+
+	// types are shared declarations (TS types, JS typedefs): keep each block once
+	if tmpl.Lookup("types") != nil {
+		types, err := renderSection(tmpl, "types", svc)
+		if err != nil {
+			return err
+		}
+		for _, block := range strings.Split(types, "\n\n") {
+			block = strings.Trim(block, "\n")
+			if block != "" && !contains(sf.Types, block) {
+				sf.Types = append(sf.Types, block)
+			}
+		}
+	}
+
+	body, err := renderSection(tmpl, "body", svc)
+	if err != nil {
+		return err
+	}
+	sf.Bodies = append(sf.Bodies, body)
+	return nil
 }
 
 func main() {
@@ -81,16 +682,53 @@ func main() {
 	}
 
 	// 2) parse param (e.g. "cs_server,cs_client,js_server,js_client,ts_server,ts_client")
-	paramStr := req.GetParameter()
-	params := parseGeneratorParams(paramStr)
-	genCSClient := (params["cs_client"] == "true")
-	genCSServer := (params["cs_server"] == "true")
-	genJSClient := (params["js_client"] == "true")
-	genJSServer := (params["js_server"] == "true")
-	genTSClient := (params["ts_client"] == "true")
-	genTSServer := (params["ts_server"] == "true")
+	//    options take a value (e.g. "cs_client,cs_transport_method=InvokeAsync")
+	opts := parseGeneratorOptions(&req)
+	opts.validate()
+	renameMapUsed := make(map[string]bool) // old names that got a shim
+
+	factoryTmpls := map[string]*template.Template{"cs": csharpFactoryTmpl, "js": jsFactoryTmpl, "ts": tsFactoryTmpl}
+	facades := make(map[string]*facadeInfo) // gen_facade: clients of the whole run per language
+	var diClients []facadeClient            // cs_gen_di_extensions: C# clients of the whole run
 
 	resp := &pluginpb.CodeGeneratorResponse{}
+	report := generationReport{Protos: []protoReport{}, Files: []string{}}
+	typeMapUsed := make(map[string]bool)  // request/response types of the generated methods
+	patternFiles := make(map[string]bool) // files named by filename_pattern so far
+	extendedTypes := collectExtendedTypes(req.ProtoFile)
+	// all messages of the request, before any file is generated: protoc may
+	// send a file ahead of the files it imports
+	csTypeNamespaces := collectCsTypeNamespaces(req.ProtoFile)
+	phpClasses := collectPhpClassNames(req.ProtoFile)
+	runtime := opts.runtimeInfo()
+	// js_typedefs: the top-level messages and all enums of the request by proto
+	// full name, so types imported from other protos are documented too
+	var typedefMessages map[string]messageInfo
+	var typedefEnums map[string]enumInfo
+	if opts.jsTypedefs {
+		typedefMessages = make(map[string]messageInfo)
+		typedefEnums = make(map[string]enumInfo)
+		for _, fd := range req.ProtoFile {
+			for _, msg := range collectMessages(fd, opts.jsNsSep, opts.jsInt64, false) {
+				typedefMessages[strings.TrimPrefix(qualifiedName(fd.GetPackage(), msg.Name), ".")] = msg
+			}
+			for _, e := range collectEnums(fd, opts.jsNsSep) {
+				typedefEnums[e.FullName] = e
+			}
+		}
+	}
+	var sensitive *sensitiveIndex
+	if opts.genLogHook {
+		sensitive = newSensitiveIndex(req.ProtoFile)
+	}
+	var schemaGen *jsonSchemaGenerator
+	if opts.genJSONSchema {
+		schemaGen = newJSONSchemaGenerator(req.ProtoFile, req.FileToGenerate, opts.jsonEnum == "number")
+	}
+	var exampleGen *exampleGenerator
+	if opts.genExamples {
+		exampleGen = newExampleGenerator(req.ProtoFile, opts.jsonEnum == "number")
+	}
 
 	// 3) .proto file -> .cs, .js file
 	for _, fd := range req.ProtoFile {
@@ -98,131 +736,738 @@ func main() {
 		if !contains(req.FileToGenerate, filename) {
 			continue
 		}
+		firstFile, firstWarning := len(resp.File), len(warnings)
+		fileOpts := opts.forFile(filename) // targets of this file, see (webviewrpc.targets)
 		baseName := strings.TrimSuffix(filename, filepath.Ext(filename))
+		csharpNamespace := getCsharpNamespace(fd) // per file, packages may differ within one request
+		if opts.csNamespace != "" {
+			csharpNamespace = opts.csNamespace
+		} else if (fileOpts.genCSClient || fileOpts.genCSServer) && !opts.csNoNamespace && csharpMessageNamespace(fd) == "" {
+			warn("%s declares no package or csharp_namespace, generating C# into %s; set cs_namespace to choose one", filename, csharpNamespace)
+		}
+		if opts.csNamespaceSuffix != "" {
+			csharpNamespace += "." + opts.csNamespaceSuffix
+		}
+		if opts.csNoNamespace {
+			csharpNamespace = ""
+		}
+		csUsingNamespace := ""
+		if ns := csharpMessageNamespace(fd); ns != csharpNamespace {
+			csUsingNamespace = csEscapeNamespace(ns)
+		}
+		csharpNamespace = csEscapeNamespace(csharpNamespace)
+		messages := collectMessages(fd, opts.jsNsSep, opts.jsInt64, false)
+		enums := collectEnums(fd, opts.jsNsSep)
+
+		// single_file: per-language output buffered until all services are rendered
+		singleFiles := make(map[string]*singleFileInfo)
+
+		// gen_client_factory: client classes accumulated per language
+		factories := make(map[string]*factoryInfo)
+
+		// JS/TS type name -> message it stands for, see checkJsTypeNames; one
+		// scope per file with single_file, whose services share declarations
+		jsTypeNames := make(map[string]string)
+
+		comments := collectComments(fd)
 
 		// collect service info
-		for _, svc := range fd.GetService() {
+		for si, svc := range fd.GetService() {
 			svcName := svc.GetName()
 
 			// collect method info
 			var methods []methodInfo
-			for _, m := range svc.GetMethod() {
+			for mi, m := range svc.GetMethod() {
+				idempotency := m.GetOptions().GetIdempotencyLevel()
+				noSideEffects := idempotency == descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+				timeoutMs := opts.defaultTimeoutMs
+				if v, ok := readVarintOption(m.GetOptions(), optTimeoutMs); ok {
+					timeoutMs = int(int32(v))
+				}
+				authOpt, _ := readVarintOption(m.GetOptions(), optRequireAuth)
+				snippet := methodSnippetData{
+					Service:    svcName,
+					Method:     m.GetName(),
+					InputType:  csTypeName(m.GetInputType(), opts.typeMap, opts.csProtobufNs, csTypeNamespaces, fd),
+					OutputType: csTypeName(m.GetOutputType(), opts.typeMap, opts.csProtobufNs, csTypeNamespaces, fd),
+				}
+				requireAuth := authOpt != 0
+				serverStreaming := m.GetServerStreaming() && opts.genStreaming
+				if serverStreaming || timeoutMs < 0 {
+					timeoutMs = 0
+				}
+				if timeoutMs > 0 {
+					runtime.HasTimeouts = true
+				}
+				for _, typ := range []string{m.GetInputType(), m.GetOutputType()} {
+					if extendedTypes[typ] {
+						warn("%s.%s uses %s, which is extended: extension fields are not part of the generated types", svcName, m.GetName(), strings.TrimPrefix(typ, "."))
+					}
+				}
+				typeMapUsed[m.GetInputType()] = true
+				typeMapUsed[m.GetOutputType()] = true
 				methods = append(methods, methodInfo{
-					MethodName: m.GetName(),
-					InputType:  shortTypeName(m.GetInputType()),
-					OutputType: shortTypeName(m.GetOutputType()),
+					MethodName:   m.GetName(),
+					CsMethodName: m.GetName(),
+					InputType:    csTypeName(m.GetInputType(), opts.typeMap, opts.csProtobufNs, csTypeNamespaces, fd),
+					OutputType:   csTypeName(m.GetOutputType(), opts.typeMap, opts.csProtobufNs, csTypeNamespaces, fd),
+
+					JsInputType:  jsTypeRef(m.GetInputType(), opts.jsNsSep, opts.typeMap),
+					JsOutputType: jsTypeRef(m.GetOutputType(), opts.jsNsSep, opts.typeMap),
+
+					PhpInputType:  phpTypeName(m.GetInputType(), phpClasses, phpNamespace(fd)),
+					PhpOutputType: phpTypeName(m.GetOutputType(), phpClasses, phpNamespace(fd)),
+
+					ProtoInputType:  strings.TrimPrefix(m.GetInputType(), "."),
+					ProtoOutputType: strings.TrimPrefix(m.GetOutputType(), "."),
+
+					CsResultType: resultType(csTypeName(m.GetOutputType(), opts.typeMap, opts.csProtobufNs, csTypeNamespaces, fd), "TracedResponse<%s>", opts.genTrace),
+					JsResultType: resultType(jsTypeRef(m.GetOutputType(), opts.jsNsSep, opts.typeMap), "Traced<%s>", opts.genTrace),
+
+					ClientStreaming:      m.GetClientStreaming(),
+					ServerStreaming:      serverStreaming,
+					ProtoServerStreaming: m.GetServerStreaming(),
+
+					NoSideEffects: noSideEffects,
+					Idempotent:    noSideEffects || idempotency == descriptorpb.MethodOptions_IDEMPOTENT,
+
+					Cached:  opts.genCache && noSideEffects && !serverStreaming,
+					Deduped: opts.genDedupe && (noSideEffects || idempotency == descriptorpb.MethodOptions_IDEMPOTENT) && !serverStreaming,
+
+					Optimistic: opts.genOptimistic && !noSideEffects && !serverStreaming,
+
+					TimeoutMs: timeoutMs,
+
+					RequireAuth: requireAuth,
+
+					CsPrologue: renderMethodSnippet(opts.csMethodPrologue, snippet),
+					CsEpilogue: renderMethodSnippet(opts.csMethodEpilogue, snippet),
+
+					Comment: comments[commentPath(pathService, int32(si), pathMethod, int32(mi))],
 				})
 			}
 
-			svcData := serviceInfo{
-				CsharpNamespace: getCsharpNamespace(fd),
-				ServiceName:     svcName,
-				Methods:         methods,
-				AllMessages:     collectAllMessages(fd),
-				ProtoBaseName:   baseName,
+			if fileOpts.genJSClient || fileOpts.genJSServer || fileOpts.genTSClient || fileOpts.genTSServer {
+				if !opts.singleFile {
+					jsTypeNames = make(map[string]string)
+				}
+				checkJsTypeNames(jsTypeNames, methods, opts.typeMap)
+			}
+			if fileOpts.genCSClient || fileOpts.genCSServer {
+				resolveCsMethodNames(svcName, methods, fileOpts.genCSClient, fileOpts.genCSServer, opts.csStreamStyle == "callback", opts.csMethodCase == "pascal", opts.methodNameCollision)
+			}
+			shims := collectRenameShims(svcName, methods, opts.renameMap)
+			for _, s := range shims {
+				renameMapUsed[s.OldName] = true
+			}
+			if fileOpts.genJSClient || fileOpts.genTSClient {
+				checkJsMethodNames(svcName, methods, opts.genRawOverload, shims)
+			}
+			var jsClasses, csClasses []string
+			if fileOpts.genJSClient || fileOpts.genTSClient {
+				jsClasses = append(jsClasses, svcName+"Client", svcName+"ServiceName")
+				if fileOpts.genTSClient && opts.tsGenInterface {
+					jsClasses = append(jsClasses, "I"+svcName+"Client")
+				}
+				if fileOpts.genJSClient && opts.genBatch {
+					jsClasses = append(jsClasses, svcName+"Batch")
+				}
+				if opts.genStreamManager && hasServerStreaming(methods) {
+					jsClasses = append(jsClasses, svcName+"StreamManager")
+				}
+			}
+			if fileOpts.genJSServer || fileOpts.genTSServer {
+				jsClasses = append(jsClasses, svcName, svcName+"Base", svcName+"ServiceName")
+			}
+			if fileOpts.genCSClient {
+				csClasses = append(csClasses, svcName+"Client")
+			}
+			if fileOpts.genCSServer {
+				csClasses = append(csClasses, svcName, svcName+"Base")
+			}
+			checkServiceClassNames(svcName, methods, jsClasses, csClasses)
+
+			svcData := opts.serviceInfo(methods)
+			svcData.CsharpNamespace = csharpNamespace
+			svcData.CsUsingNamespace = csUsingNamespace
+			svcData.PhpNamespace = phpNamespace(fd)
+			svcData.ServiceName = svcName
+			svcData.ProtoServiceName = strings.TrimPrefix(qualifiedName(fd.GetPackage(), svcName), ".")
+			svcData.RenameShims = shims
+			svcData.Comment = comments[commentPath(pathService, int32(si))]
+			svcData.AllMessages = collectAllMessages(fd)
+			svcData.Messages = messages
+			svcData.HasOneofs = hasOneofs(messages)
+			svcData.TsInterfaces, svcData.TsOneofInterfaces = collectTsInterfaces(svcData.TypeNames, messages, enums)
+			if svcData.HasOneofs && fileOpts.genTSClient {
+				runtime.TsOneofs = true
+			}
+			svcData.Enums = enums
+			svcData.ProtoBaseName = baseName
+			svcData.ReflectionJSON = reflectionJSON(svcName, methods)
+			if sensitive != nil {
+				svcData.SensitiveMessages = sensitive.forMethods(methods)
+			}
+			svcData.JsRuntimePath = runtimeImportPath(baseName)
+			svcData.ClientRuntimeImports = collectClientRuntimeImports(svcData, "js")
+			svcData.ServerRuntimeImports = collectServerRuntimeImports(svcData)
+			svcData.TsClientRuntimeImports, svcData.TsServerRuntimeImports = collectTsRuntimeImports(svcData)
+			svcData.Typedefs, svcData.TypedefEnums = collectTypedefs(methods, typedefMessages, typedefEnums, opts.typeMap)
+			if svcData.StreamPoll {
+				runtime.StreamPoll = true
+			}
+			if svcData.GenBackpressure {
+				runtime.GenBackpressure = true
 			}
 
-			// (A) C# Client
-			if genCSClient {
-				out, e := renderTemplate(csharpClientTmpl, svcData)
+			for _, t := range fileOpts.targets() {
+				if !t.enabled {
+					continue
+				}
+				if only := opts.serviceFilters[t.lang+"_"+t.role]; only != nil && !only[svcName] {
+					continue
+				}
+				fileName := fmt.Sprintf(t.fileName, baseName, svcName)
+				if opts.filenamePattern != "" {
+					fileName = expandFilenamePattern(opts.filenamePattern, baseName, svcName, t)
+					if patternFiles[fileName] {
+						fail("filename_pattern %q generates %s more than once: add {proto}, {role} or {lang} to tell the files apart", opts.filenamePattern, fileName)
+					}
+					patternFiles[fileName] = true
+				}
+				// module of the client, as imported by factories, facades and tests
+				clientModule := strings.TrimSuffix(fileName, "."+t.lang)
+				if opts.singleFile && t.lang != "php" {
+					clientModule = strings.TrimSuffix(singleFileName(baseName, t.lang), "."+t.lang)
+				}
+				if opts.genClientFactory && t.role == "client" && t.lang != "php" {
+					fi := factories[t.lang]
+					if fi == nil {
+						fi = &factoryInfo{CsharpNamespace: csharpNamespace, CsAccess: opts.csAccess, ProtoBaseName: filepath.Base(baseName)}
+						factories[t.lang] = fi
+					}
+					fi.Clients = append(fi.Clients, factoryClient{
+						ServiceName: svcName,
+						ImportPath:  relativeImportPath(baseName, clientModule),
+					})
+				}
+				if opts.genFacade && t.role == "client" && t.lang != "php" {
+					fi := facades[t.lang]
+					if fi == nil {
+						fi = &facadeInfo{CsAccess: opts.csAccess}
+						facades[t.lang] = fi
+					}
+					for _, c := range fi.Clients {
+						if c.ServiceName == svcName {
+							fail("gen_facade: several protos declare a service %s, which would be the same facade property", svcName)
+						}
+					}
+					fi.Clients = append(fi.Clients, newFacadeClient(svcName, csharpNamespace, relativeImportPath("webviewrpc_facade", clientModule)))
+				}
+				if opts.csGenDiExtensions && t.role == "client" && t.lang == "cs" {
+					diClients = append(diClients, newFacadeClient(svcName, csharpNamespace, ""))
+				}
+				if opts.genTests && t.role == "client" && (t.lang == "cs" || t.lang == "js") {
+					testFile := fmt.Sprintf("%s_%sClientTests.cs", baseName, svcName)
+					tmpl := csharpTestsTmpl
+					if t.lang == "js" {
+						testFile = fmt.Sprintf("%s_%sClient.test.js", baseName, svcName)
+						tmpl = jsTestsTmpl
+					}
+					data := testScaffoldInfo{
+						serviceInfo:      svcData,
+						ClientImportPath: relativeImportPath(testFile, clientModule),
+						CodecImportPath:  relativeImportPath(testFile, filepath.Join(filepath.Dir(clientModule), svcName)),
+					}
+					for _, m := range methods {
+						if fn := "encode" + m.JsOutputType; !contains(data.ResponseEncoders, fn) {
+							data.ResponseEncoders = append(data.ResponseEncoders, fn)
+						}
+					}
+					data.JsRuntimePath = runtimeImportPath(testFile)
+					out, e := renderTemplate(tmpl, data)
+					if e != nil {
+						appendError(resp, e.Error())
+					} else {
+						if t.lang == "cs" && opts.csNoNamespace {
+							out = unindentNamespace(out)
+						}
+						addFile(resp, testFile, out)
+					}
+				}
+				if opts.singleFile && t.lang != "php" {
+					sf := singleFiles[t.lang]
+					if sf == nil {
+						sf = &singleFileInfo{CsharpNamespace: csharpNamespace, ProtoBaseName: baseName}
+						singleFiles[t.lang] = sf
+					}
+					if e := sf.add(t.tmpl, svcData); e != nil {
+						appendError(resp, e.Error())
+					}
+					continue
+				}
+				data := svcData
+				data.JsRuntimePath = runtimeImportPath(fileName) // filename_pattern may move the file
+				out, e := renderTemplate(t.tmpl, data)
 				if e != nil {
 					appendError(resp, e.Error())
 				} else {
-					fileName := fmt.Sprintf("%s_%sClient.cs", baseName, svcName)
-					resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
-						Name:    &fileName,
-						Content: &out,
-					})
+					if t.lang == "cs" && opts.csNoNamespace {
+						out = unindentNamespace(out)
+					}
+					addFile(resp, fileName, out)
 				}
 			}
 
-			// (B) C# Server
-			if genCSServer {
-				out, e := renderTemplate(csharpServerTmpl, svcData)
+			if opts.genMarkdown {
+				out, e := renderTemplate(markdownTmpl, svcData)
 				if e != nil {
 					appendError(resp, e.Error())
 				} else {
-					fileName := fmt.Sprintf("%s_%sBase.cs", baseName, svcName)
-					resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
-						Name:    &fileName,
-						Content: &out,
-					})
+					addFile(resp, fmt.Sprintf("%s_%s.md", baseName, svcName), out)
 				}
 			}
+		}
+
+		// (G) JSON Schema of the messages
+		if schemaGen != nil {
+			out, e := schemaGen.generate(fd)
+			if e != nil {
+				appendError(resp, e.Error())
+			} else {
+				addFile(resp, baseName+".schema.json", out)
+			}
+		}
+
+		// (G1) request examples of the methods
+		if exampleGen != nil {
+			out, e := exampleGen.generate(fd)
+			if e != nil {
+				appendError(resp, e.Error())
+			} else if out != "" {
+				addFile(resp, baseName+"_examples.json", out)
+			}
+		}
+
+		// (G2) field number constants and (G2b) message factories cover nested
+		// messages too
+		allMessages := collectMessages(fd, opts.jsNsSep, opts.jsInt64, true)
 
-			// (C) JS Client
-			if genJSClient {
-				out, e := renderTemplate(jsClientTmpl, svcData)
+		// (G2) field number constants, one file per language
+		if opts.genFieldNumbers && len(allMessages) > 0 {
+			for _, fn := range []struct {
+				enabled bool
+				lang    string
+				tmpl    *template.Template
+			}{
+				{fileOpts.genCSClient || fileOpts.genCSServer, "cs", csharpFieldNumbersTmpl},
+				{fileOpts.genJSClient || fileOpts.genJSServer, "js", jsFieldNumbersTmpl},
+				{fileOpts.genTSClient || fileOpts.genTSServer, "ts", jsFieldNumbersTmpl},
+			} {
+				if !fn.enabled {
+					continue
+				}
+				out, e := renderTemplate(fn.tmpl, protoTypesInfo{CsharpNamespace: csharpNamespace, CsAccess: opts.csAccess, ProtoBaseName: filepath.Base(baseName), Messages: allMessages})
 				if e != nil {
 					appendError(resp, e.Error())
 				} else {
-					fileName := fmt.Sprintf("%s_%sClient.js", baseName, svcName)
-					resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
-						Name:    &fileName,
-						Content: &out,
-					})
+					if fn.lang == "cs" && opts.csNoNamespace {
+						out = unindentNamespace(out)
+					}
+					addFile(resp, fmt.Sprintf("%s_FieldNumbers.%s", baseName, fn.lang), out)
 				}
 			}
+		}
 
-			// (D) JS Server
-			if genJSServer {
-				out, e := renderTemplate(jsServerTmpl, svcData)
+		// (G2b) message factories by full name, one file per language
+		if opts.genMessageRegistry && len(allMessages) > 0 {
+			for _, mr := range []struct {
+				enabled bool
+				lang    string
+				tmpl    *template.Template
+			}{
+				{fileOpts.genCSClient || fileOpts.genCSServer, "cs", csharpMessageRegistryTmpl},
+				{fileOpts.genJSClient || fileOpts.genJSServer, "js", jsMessageRegistryTmpl},
+				{fileOpts.genTSClient || fileOpts.genTSServer, "ts", tsMessageRegistryTmpl},
+			} {
+				if !mr.enabled {
+					continue
+				}
+				out, e := renderTemplate(mr.tmpl, protoTypesInfo{
+					CsharpNamespace:  csharpNamespace,
+					CsAccess:         opts.csAccess,
+					CsUsingNamespace: csUsingNamespace,
+					CsProtobufNs:     opts.csProtobufNs,
+					ProtoBaseName:    filepath.Base(baseName),
+					ClassName:        toPascalCase(nonIdentRe.ReplaceAllString(filepath.Base(baseName), "_")),
+					Messages:         allMessages,
+				})
 				if e != nil {
 					appendError(resp, e.Error())
 				} else {
-					fileName := fmt.Sprintf("%s_%sBase.js", baseName, svcName)
-					resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
-						Name:    &fileName,
-						Content: &out,
-					})
+					if mr.lang == "cs" && opts.csNoNamespace {
+						out = unindentNamespace(out)
+					}
+					addFile(resp, fmt.Sprintf("%s_MessageRegistry.%s", baseName, mr.lang), out)
 				}
 			}
+		}
 
-			// (E) TS Client
-			if genTSClient {
-				out, e := renderTemplate(tsClientTmpl, svcData)
-				if e != nil {
-					appendError(resp, e.Error())
-				} else {
-					fileName := fmt.Sprintf("%s_%sClient.ts", baseName, svcName)
-					resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
-						Name:    &fileName,
-						Content: &out,
-					})
+		// (G3) C# enum names, the TS client declares its enums with theirs
+		if opts.genEnumNames && (fileOpts.genCSClient || fileOpts.genCSServer) && len(enums) > 0 {
+			out, e := renderTemplate(csharpEnumNamesTmpl, protoTypesInfo{
+				CsharpNamespace:  csharpNamespace,
+				CsAccess:         opts.csAccess,
+				CsUsingNamespace: csUsingNamespace,
+				ProtoBaseName:    filepath.Base(baseName),
+				ClassName:        toPascalCase(nonIdentRe.ReplaceAllString(filepath.Base(baseName), "_")) + "EnumExtensions",
+				Enums:            enums,
+			})
+			if e != nil {
+				appendError(resp, e.Error())
+			} else {
+				if opts.csNoNamespace {
+					out = unindentNamespace(out)
 				}
+				addFile(resp, baseName+"_EnumNames.cs", out)
 			}
+		}
 
-			// (F) TS Server
-			if genTSServer {
-				out, e := renderTemplate(tsServerTmpl, svcData)
-				if e != nil {
-					appendError(resp, e.Error())
-				} else {
-					fileName := fmt.Sprintf("%s_%sBase.ts", baseName, svcName)
-					resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
-						Name:    &fileName,
-						Content: &out,
-					})
+		// (H) client factories, one per language
+		for _, lang := range []string{"cs", "js", "ts"} {
+			fi := factories[lang]
+			if fi == nil {
+				continue
+			}
+			out, e := renderTemplate(factoryTmpls[lang], fi)
+			if e != nil {
+				appendError(resp, e.Error())
+			} else {
+				if lang == "cs" && opts.csNoNamespace {
+					out = unindentNamespace(out)
 				}
+				addFile(resp, fmt.Sprintf("%s_WebviewRpcClients.%s", baseName, lang), out)
 			}
 		}
-	}
 
-	// 4) serialize response -> stdout
-	outBytes, err := proto.Marshal(resp)
-	if err != nil {
-		fail("failed to marshal CodeGeneratorResponse: %v", err)
-	}
-	os.Stdout.Write(outBytes)
-}
+		// (I) single_file wrappers, one per language
+		for _, lang := range []string{"cs", "js", "ts"} {
+			sf := singleFiles[lang]
+			if sf == nil {
+				continue
+			}
+			wrapper := jsFileTmpl
+			if lang == "cs" {
+				wrapper = csharpFileTmpl
+			}
+			out, e := renderTemplate(wrapper, sf)
+			if e != nil {
+				appendError(resp, e.Error())
+			} else {
+				if lang == "cs" && opts.csNoNamespace {
+					out = unindentNamespace(out)
+				}
+				addFile(resp, singleFileName(baseName, lang), out)
+			}
+		}
 
-// ---------- Helper -----------
+		// file systems limit each path component, typically to 255 bytes
+		for _, f := range resp.File[firstFile:] {
+			for _, part := range strings.Split(f.GetName(), "/") {
+				if opts.maxFilenameLen > 0 && len(part) > opts.maxFilenameLen {
+					warn("%s: file name %s is %d bytes, over max_filename_len %d; shorten the service name or use single_file", filename, part, len(part), opts.maxFilenameLen)
+				}
+			}
+		}
 
-func fail(format string, args ...interface{}) {
+		if opts.genReport {
+			pr := protoReport{Proto: filename, Services: []string{}, Targets: []string{}, Files: []string{}}
+			for _, svc := range fd.GetService() {
+				pr.Services = append(pr.Services, svc.GetName())
+				pr.Methods += len(svc.GetMethod())
+			}
+			if len(pr.Services) > 0 {
+				for _, t := range fileOpts.targets() {
+					if t.enabled {
+						pr.Targets = append(pr.Targets, t.lang+"_"+t.role)
+					}
+				}
+			}
+			for _, f := range resp.File[firstFile:] {
+				pr.Files = append(pr.Files, f.GetName())
+			}
+			pr.Warnings = append(pr.Warnings, warnings[firstWarning:]...)
+			report.Protos = append(report.Protos, pr)
+		}
+	}
+	var unusedMappings []string
+	for from := range opts.typeMap {
+		if !typeMapUsed[from] {
+			unusedMappings = append(unusedMappings, from[1:])
+		}
+	}
+	sort.Strings(unusedMappings)
+	for _, from := range unusedMappings {
+		warn("type_map entry for %s is unused: no generated method takes or returns it", from)
+	}
+	var unusedRenames []string
+	for from := range opts.renameMap {
+		if !renameMapUsed[from] {
+			unusedRenames = append(unusedRenames, from)
+		}
+	}
+	sort.Strings(unusedRenames)
+	for _, from := range unusedRenames {
+		warn("rename_map entry %s=%s is unused: no service has a unary method %s", from, opts.renameMap[from], opts.renameMap[from])
+	}
+	sharedFiles, laterWarnings := len(resp.File), len(warnings)
+
+	// (K) gen_facade: one facade per language over the clients of every proto
+	for _, f := range []struct {
+		lang     string
+		tmpl     *template.Template
+		fileName string
+	}{
+		{"cs", csharpFacadeTmpl, "WebviewRpc.cs"},
+		{"js", jsFacadeTmpl, "webviewrpc_facade.js"},
+		{"ts", tsFacadeTmpl, "webviewrpc_facade.ts"},
+	} {
+		fi := facades[f.lang]
+		if fi == nil {
+			continue
+		}
+		out, e := renderTemplate(f.tmpl, fi)
+		if e != nil {
+			appendError(resp, e.Error())
+		} else {
+			addFile(resp, f.fileName, out)
+		}
+	}
+
+	// (K2) cs_gen_di_extensions: AddWebviewRpcClients over the C# clients of every proto
+	if diClients != nil {
+		out, e := renderTemplate(csharpDiTmpl, diInfo{CsAccess: opts.csAccess, Lifetime: toPascalCase(opts.csDiLifetime), Clients: diClients})
+		if e != nil {
+			appendError(resp, e.Error())
+		} else {
+			addFile(resp, "WebviewRpcServiceCollectionExtensions.cs", out)
+		}
+	}
+
+	// (J) runtime support files, once per language
+	for _, rt := range []struct {
+		enabled  bool
+		lang     string
+		tmpl     *template.Template
+		fileName string
+	}{
+		{opts.genCSClient || opts.genCSServer, "cs", csharpRuntimeTmpl, "WebViewRpcRuntime.cs"},
+		{opts.genJSClient || opts.genJSServer, "js", jsRuntimeTmpl, "webviewrpc_runtime.js"},
+		{opts.genTSClient || opts.genTSServer, "ts", tsRuntimeTmpl, "webviewrpc_runtime.ts"},
+	} {
+		if !rt.enabled || !runtime.needed(rt.lang) {
+			continue
+		}
+		out, e := renderTemplate(rt.tmpl, runtime)
+		if e != nil {
+			appendError(resp, e.Error())
+		} else {
+			addFile(resp, rt.fileName, out)
+		}
+	}
+
+	// 4) post-process every generated file: optional formatter, then line endings
+	for _, f := range resp.File {
+		content := f.GetContent()
+		if opts.csFileScopedNamespace && strings.HasSuffix(f.GetName(), ".cs") {
+			content = fileScopeNamespace(f.GetName(), content)
+		}
+		if len(opts.csFormatCmd) > 0 && strings.HasSuffix(f.GetName(), ".cs") {
+			content = formatWithCommand(opts.csFormatCmd, f.GetName(), content)
+		}
+		content = normalizeEOL(content, opts.eol)
+		f.Content = &content
+	}
+
+	// 5) gen_report: summary of the run, once everything else is generated
+	if opts.genReport {
+		for _, f := range resp.File[sharedFiles:] {
+			report.Files = append(report.Files, f.GetName())
+		}
+		report.Warnings = warnings[laterWarnings:]
+		out, e := json.MarshalIndent(report, "", "  ")
+		if e != nil {
+			appendError(resp, e.Error())
+		} else {
+			addFile(resp, "webviewrpc_report.json", normalizeEOL(string(out)+"\n", opts.eol))
+		}
+	}
+
+	// 6) serialize response -> stdout
+	outBytes, err := proto.Marshal(resp)
+	if err != nil {
+		fail("failed to marshal CodeGeneratorResponse: %v", err)
+	}
+	os.Stdout.Write(outBytes)
+}
+
+// ---------- Helper -----------
+
+func fail(format string, args ...interface{}) {
 	fmt.Fprintf(os.Stderr, format+"\n", args...)
 	os.Exit(1)
 }
 
+// field numbers of the custom options declared in webviewrpc/options.proto
+const (
+	optTimeoutMs   protowire.Number = 50001
+	optRequireAuth protowire.Number = 50002
+	optSensitive   protowire.Number = 50003 // FieldOptions
+	optTargets     protowire.Number = 50004 // FileOptions
+)
+
+// readVarintOption reads a custom option of opts. The plugin has no Go types
+// for webviewrpc/options.proto, so the option stays in the unknown fields.
+func readVarintOption(opts proto.Message, num protowire.Number) (uint64, bool) {
+	b, found := readUnknownOption(opts, num, protowire.VarintType)
+	if !found {
+		return 0, false
+	}
+	value, _ := protowire.ConsumeVarint(b)
+	return value, true
+}
+
+// readStringOption is readVarintOption for string options.
+func readStringOption(opts proto.Message, num protowire.Number) (string, bool) {
+	b, found := readUnknownOption(opts, num, protowire.BytesType)
+	if !found {
+		return "", false
+	}
+	value, _ := protowire.ConsumeBytes(b)
+	return string(value), true
+}
+
+// readUnknownOption returns the encoded value of the last occurrence of
+// field num in the unknown fields of opts.
+func readUnknownOption(opts proto.Message, num protowire.Number, want protowire.Type) ([]byte, bool) {
+	if opts == nil || !opts.ProtoReflect().IsValid() {
+		return nil, false
+	}
+	var value []byte
+	found := false
+	b := opts.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		n, typ, tagLen := protowire.ConsumeTag(b)
+		if tagLen < 0 {
+			return nil, false
+		}
+		b = b[tagLen:]
+		valLen := protowire.ConsumeFieldValue(n, typ, b)
+		if valLen < 0 {
+			return nil, false
+		}
+		if n == num && typ == want {
+			value = b[:valLen]
+			found = true // last occurrence wins
+		}
+		b = b[valLen:]
+	}
+	return value, found
+}
+
+// targetParams are the parameters selecting what is generated, which
+// (webviewrpc.targets) may also list.
+var targetParams = []string{"cs_client", "cs_server", "js_client", "js_server", "ts_client", "ts_server", "php_client"}
+
+// collectFileTargets reads the (webviewrpc.targets) option of the files to
+// generate, e.g. "cs_client,js_server": file name -> targets it lists. Files
+// without the option are left out.
+func collectFileTargets(files []*descriptorpb.FileDescriptorProto, toGenerate []string) map[string]map[string]bool {
+	fileTargets := make(map[string]map[string]bool)
+	for _, fd := range files {
+		if !contains(toGenerate, fd.GetName()) {
+			continue
+		}
+		value, ok := readStringOption(fd.GetOptions(), optTargets)
+		if !ok {
+			continue
+		}
+		listed := make(map[string]bool)
+		for _, t := range strings.Split(value, ",") {
+			t = strings.TrimSpace(t)
+			if t == "" {
+				continue
+			}
+			if !contains(targetParams, t) {
+				fail("%s: invalid (webviewrpc.targets) entry %q: expected %s", fd.GetName(), t, strings.Join(targetParams, ", "))
+			}
+			listed[t] = true
+		}
+		fileTargets[fd.GetName()] = listed
+	}
+	return fileTargets
+}
+
+// methodSnippetData is what cs_method_prologue and cs_method_epilogue can
+// refer to, e.g. {{.Service}}.{{.Method}}.
+type methodSnippetData struct {
+	Service    string
+	Method     string
+	InputType  string // C# type names
+	OutputType string
+}
+
+// parseMethodSnippet parses the text/template of a cs_method_prologue or
+// cs_method_epilogue parameter, nil when it is not set.
+func parseMethodSnippet(param, text string) *template.Template {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	t, err := template.New(param).Option("missingkey=error").Parse(text)
+	if err != nil {
+		fail("invalid %s: %v", param, err)
+	}
+	return t
+}
+
+// renderMethodSnippet renders a snippet of parseMethodSnippet for a method,
+// without trailing newlines: templates emit it one line at a time.
+func renderMethodSnippet(t *template.Template, data methodSnippetData) string {
+	if t == nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		fail("invalid %s: %v", t.Name(), err)
+	}
+	return strings.TrimRight(buf.String(), "\r\n")
+}
+
+// warnings holds every warning of the run, for gen_report.
+var warnings []string
+
+// warn reports a non-fatal problem; protoc shows plugin stderr to the user.
+func warn(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "protoc-gen-webviewrpc: warning: "+format+"\n", args...)
+	warnings = append(warnings, fmt.Sprintf(format, args...))
+}
+
+// repeatableParams may be given several times, their values are joined by
+// newlines instead of the last one winning.
+var repeatableParams = map[string]bool{
+	"type_map":       true,
+	"rename_map":     true,
+	"cs_client_only": true,
+	"cs_server_only": true,
+	"js_client_only": true,
+	"js_server_only": true,
+	"ts_client_only": true,
+	"ts_server_only": true,
+
+	"cs_method_prologue": true,
+	"cs_method_epilogue": true,
+}
+
 func parseGeneratorParams(paramStr string) map[string]string {
 	m := make(map[string]string)
 	if paramStr == "" {
@@ -231,13 +1476,51 @@ func parseGeneratorParams(paramStr string) map[string]string {
 	parts := strings.Split(paramStr, ",")
 	for _, p := range parts {
 		p = strings.TrimSpace(p)
-		if p != "" {
+		if p == "" {
+			continue
+		}
+		// "key=value" sets an option, a bare "key" is a flag
+		if k, v, ok := strings.Cut(p, "="); ok {
+			k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+			// "b64:" values carry text that would clash with the parameter
+			// syntax, e.g. commas
+			if enc, isB64 := strings.CutPrefix(v, "b64:"); isB64 {
+				dec, err := base64.StdEncoding.DecodeString(enc)
+				if err != nil {
+					fail("invalid base64 value for %s: %v", k, err)
+				}
+				v = string(dec)
+			}
+			if prev, seen := m[k]; seen && repeatableParams[k] {
+				v = prev + "\n" + v
+			}
+			m[k] = v
+		} else {
 			m[p] = "true"
 		}
 	}
 	return m
 }
 
+func paramOrDefault(params map[string]string, key, def string) string {
+	if v := params[key]; v != "" {
+		return v
+	}
+	return def
+}
+
+func intParamOrDefault(params map[string]string, key string, def int) int {
+	v := params[key]
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		fail("invalid %s %q: expected a non-negative integer", key, v)
+	}
+	return n
+}
+
 func contains(arr []string, s string) bool {
 	for _, v := range arr {
 		if v == s {
@@ -247,8 +1530,12 @@ func contains(arr []string, s string) bool {
 	return false
 }
 
+// shortTypeName is the last segment of a fully-qualified proto type, e.g.
+// ".helloworld.HelloRequest" -> "HelloRequest". The name is kept verbatim,
+// leading underscores included ("._Internal" -> "_Internal"): protoc's C#
+// and PHP generators and pbjs name the message class the same way, and it is
+// a valid identifier in every target language.
 func shortTypeName(full string) string {
-	// e.g. ".helloworld.HelloRequest" -> "helloworld.HelloRequest"
 	s := strings.TrimPrefix(full, ".")
 	// split
 	parts := strings.Split(s, ".")
@@ -256,17 +1543,521 @@ func shortTypeName(full string) string {
 	return parts[len(parts)-1]
 }
 
+var jsIdentRe = regexp.MustCompile(`^[A-Za-z0-9_$]*$`)
+
+// nonIdentRe matches the characters a C# identifier cannot contain.
+var nonIdentRe = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// csNamespaceRe matches a dotted C# namespace such as "Google.Protobuf".
+var csNamespaceRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// jsTypeName flattens a fully-qualified proto type into a JS identifier joined
+// by sep, e.g. ".helloworld.HelloRequest" -> "helloworld__HelloRequest" for
+// "__". Without a separator only the short name is used.
+func jsTypeName(full, sep string) string {
+	if sep == "" {
+		return shortTypeName(full)
+	}
+	return strings.ReplaceAll(strings.TrimPrefix(full, "."), ".", sep)
+}
+
+// parseTypeMap reads the "proto.Type=Target.Type" entries of type_map into a
+// map keyed by fully-qualified proto name (".proto.Type"). Keys must name a
+// message of the request.
+func parseTypeMap(value string, files []*descriptorpb.FileDescriptorProto) map[string]string {
+	known := make(map[string]bool)
+	var walk func(prefix string, mds []*descriptorpb.DescriptorProto)
+	walk = func(prefix string, mds []*descriptorpb.DescriptorProto) {
+		for _, md := range mds {
+			known[prefix+"."+md.GetName()] = true
+			walk(prefix+"."+md.GetName(), md.GetNestedType())
+		}
+	}
+	for _, fd := range files {
+		walk(strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), "."), fd.GetMessageType())
+	}
+
+	m := make(map[string]string)
+	for _, entry := range strings.Split(value, "\n") {
+		if entry == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(entry, "=")
+		from = "." + strings.TrimPrefix(strings.TrimSpace(from), ".")
+		to = strings.TrimSpace(to)
+		if !ok || !csNamespaceRe.MatchString(to) {
+			fail("invalid type_map entry %q: expected proto.Type=Target.Type", entry)
+		}
+		if !known[from] {
+			fail("invalid type_map entry %q: no message %s in the request", entry, from[1:])
+		}
+		m[from] = to
+	}
+	return m
+}
+
+// schemaVersionRe matches a schema_version, which is rendered in string
+// literals without escaping.
+var schemaVersionRe = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// methodNameRe matches an rpc name of rename_map.
+var methodNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// parseRenameMap reads the "OldMethod=NewMethod" entries of rename_map into a
+// map from old to new rpc name.
+func parseRenameMap(value string) map[string]string {
+	m := make(map[string]string)
+	for _, entry := range strings.Split(value, "\n") {
+		if entry == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(entry, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || !methodNameRe.MatchString(from) || !methodNameRe.MatchString(to) || from == to {
+			fail("invalid rename_map entry %q: expected OldMethod=NewMethod", entry)
+		}
+		m[from] = to
+	}
+	return m
+}
+
+// renameShim is an obsolete client method named OldName that calls the
+// renamed unary method, see rename_map.
+type renameShim struct {
+	OldName string
+	methodInfo
+}
+
+// collectRenameShims returns the rename_map shims of a service, sorted by old
+// name: one per entry whose new name is a unary method of the service. An old
+// name still taken by an rpc of the service fails.
+func collectRenameShims(svcName string, methods []methodInfo, renameMap map[string]string) []renameShim {
+	byName := make(map[string]methodInfo)
+	for _, m := range methods {
+		byName[m.MethodName] = m
+	}
+	var out []renameShim
+	for from, to := range renameMap {
+		m, ok := byName[to]
+		if !ok || m.ServerStreaming {
+			continue
+		}
+		if _, taken := byName[from]; taken {
+			fail("rename_map entry %s=%s: service %s still has an rpc %s", from, to, svcName, from)
+		}
+		out = append(out, renameShim{OldName: from, methodInfo: m})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].OldName < out[j].OldName })
+	return out
+}
+
+// csTypeName is the C# type used for proto type full, type_map first.
+func csTypeName(full string, typeMap map[string]string, csProtobufNs string, typeNamespaces map[string]string, fd *descriptorpb.FileDescriptorProto) string {
+	if t, ok := typeMap[full]; ok {
+		return t
+	}
+	if wellKnownTypes[full] {
+		return csProtobufNs + ".WellKnownTypes." + shortTypeName(full)
+	}
+	// a message of another namespace is not covered by the using of fd's
+	if ns, ok := typeNamespaces[full]; ok && ns != csharpMessageNamespace(fd) {
+		if ns == "" {
+			return "global::" + shortTypeName(full)
+		}
+		return "global::" + csEscapeNamespace(ns) + "." + shortTypeName(full)
+	}
+	return shortTypeName(full)
+}
+
+// collectCsTypeNamespaces maps the fully-qualified name of every message in
+// files to the C# namespace protoc generates it into. Types re-exported by an
+// `import public` need no special handling: protoc resolves the type names of
+// the methods to the file declaring them and sends every transitively imported
+// file, public dependencies included.
+func collectCsTypeNamespaces(files []*descriptorpb.FileDescriptorProto) map[string]string {
+	namespaces := make(map[string]string)
+	var walk func(ns, prefix string, mds []*descriptorpb.DescriptorProto)
+	walk = func(ns, prefix string, mds []*descriptorpb.DescriptorProto) {
+		for _, md := range mds {
+			namespaces[prefix+"."+md.GetName()] = ns
+			walk(ns, prefix+"."+md.GetName(), md.GetNestedType())
+		}
+	}
+	for _, fd := range files {
+		walk(csharpMessageNamespace(fd), strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), "."), fd.GetMessageType())
+	}
+	return namespaces
+}
+
+// wellKnownTypes are the google/protobuf messages C# takes from the
+// WellKnownTypes namespace of the protobuf runtime rather than from generated
+// code. JS/TS reference them by name like any other message, so the codec
+// module must export them (pbjs does for imported well-known types).
+var wellKnownTypes = map[string]bool{
+	".google.protobuf.Any":         true,
+	".google.protobuf.BoolValue":   true,
+	".google.protobuf.BytesValue":  true,
+	".google.protobuf.DoubleValue": true,
+	".google.protobuf.Duration":    true,
+	".google.protobuf.Empty":       true,
+	".google.protobuf.FieldMask":   true,
+	".google.protobuf.FloatValue":  true,
+	".google.protobuf.Int32Value":  true,
+	".google.protobuf.Int64Value":  true,
+	".google.protobuf.ListValue":   true,
+	".google.protobuf.StringValue": true,
+	".google.protobuf.Struct":      true,
+	".google.protobuf.Timestamp":   true,
+	".google.protobuf.UInt32Value": true,
+	".google.protobuf.UInt64Value": true,
+	".google.protobuf.Value":       true,
+}
+
+// jsTypeRef is the JS/TS identifier used for proto type full, type_map first.
+// Mapped types follow js_ns_sep like proto types, e.g. "Acme.CustomBar" is
+// referenced as "CustomBar" (and its encodeCustomBar/decodeCustomBar).
+func jsTypeRef(full, sep string, typeMap map[string]string) string {
+	if t, ok := typeMap[full]; ok {
+		return jsTypeName("."+t, sep)
+	}
+	return jsTypeName(full, sep)
+}
+
+// qualifiedName returns the fully-qualified proto name (".pkg.Name") of a
+// top-level definition.
+func qualifiedName(pkg, name string) string {
+	if pkg == "" {
+		return "." + name
+	}
+	return "." + pkg + "." + name
+}
+
+// getCsharpNamespace resolves the namespace from fd alone. A single request can
+// carry files from several packages, so the result must never be cached
+// across files.
 func getCsharpNamespace(fd *descriptorpb.FileDescriptorProto) string {
+	if ns := csharpMessageNamespace(fd); ns != "" {
+		return ns
+	}
+	return "DefaultNamespace"
+}
+
+// csharpMessageNamespace is the namespace protoc's C# generator puts the
+// message classes of fd in, "" for the global namespace.
+func csharpMessageNamespace(fd *descriptorpb.FileDescriptorProto) string {
 	if ns := fd.GetOptions().GetCsharpNamespace(); ns != "" {
 		return ns
 	}
-	pkg := fd.GetPackage()
-	if pkg == "" {
-		return "DefaultNamespace"
+	return csharpPackageNamespace(fd.GetPackage())
+}
+
+// csharpPackageNamespace converts a proto package the way protoc's C# generator
+// does when csharp_namespace is not set: letters following a digit, "_" or "."
+// are capitalized and "_" is dropped, e.g. "api.v2beta1.sub_pkg" becomes
+// "Api.V2Beta1.SubPkg". A segment left starting with a digit, which C# does
+// not allow, is prefixed with "_".
+func csharpPackageNamespace(pkg string) string {
+	var b strings.Builder
+	capNext := true
+	for _, r := range pkg {
+		switch {
+		case r == '_':
+			capNext = true
+		case r == '.':
+			b.WriteRune(r)
+			capNext = true
+		case unicode.IsDigit(r):
+			b.WriteRune(r)
+			capNext = true
+		case capNext:
+			b.WriteRune(unicode.ToUpper(r))
+			capNext = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	segments := strings.Split(b.String(), ".")
+	for i, s := range segments {
+		if s != "" && unicode.IsDigit(rune(s[0])) {
+			segments[i] = "_" + s
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+// csEscapeNamespace prefixes the segments of ns that are C# keywords with "@",
+// e.g. a csharp_namespace of "event.lock" becomes "@event.@lock". Packages
+// without csharp_namespace never need it, their segments are capitalized.
+func csEscapeNamespace(ns string) string {
+	if ns == "" {
+		return ns
+	}
+	segments := strings.Split(ns, ".")
+	for i, s := range segments {
+		if csharpKeywords[s] {
+			segments[i] = "@" + s
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+// csharpKeywords are the reserved C# keywords, which cannot be used as
+// identifiers without "@". Contextual keywords (async, var, ...) are valid
+// namespace segments and are left alone.
+var csharpKeywords = map[string]bool{
+	"abstract": true, "as": true, "base": true, "bool": true, "break": true,
+	"byte": true, "case": true, "catch": true, "char": true, "checked": true,
+	"class": true, "const": true, "continue": true, "decimal": true, "default": true,
+	"delegate": true, "do": true, "double": true, "else": true, "enum": true,
+	"event": true, "explicit": true, "extern": true, "false": true, "finally": true,
+	"fixed": true, "float": true, "for": true, "foreach": true, "goto": true,
+	"if": true, "implicit": true, "in": true, "int": true, "interface": true,
+	"internal": true, "is": true, "lock": true, "long": true, "namespace": true,
+	"new": true, "null": true, "object": true, "operator": true, "out": true,
+	"override": true, "params": true, "private": true, "protected": true, "public": true,
+	"readonly": true, "ref": true, "return": true, "sbyte": true, "sealed": true,
+	"short": true, "sizeof": true, "stackalloc": true, "static": true, "string": true,
+	"struct": true, "switch": true, "this": true, "throw": true, "true": true,
+	"try": true, "typeof": true, "uint": true, "ulong": true, "unchecked": true,
+	"unsafe": true, "ushort": true, "using": true, "virtual": true, "void": true,
+	"volatile": true, "while": true,
+}
+
+// phpNamespace is the namespace protoc's PHP generator puts the message
+// classes of fd in: php_namespace, else the package with each segment
+// capitalized. php_metadata_namespace only moves the GPBMetadata classes,
+// which the message classes load themselves.
+func phpNamespace(fd *descriptorpb.FileDescriptorProto) string {
+	if opts := fd.GetOptions(); opts != nil && opts.PhpNamespace != nil {
+		// set but empty: the global namespace
+		return strings.Trim(opts.GetPhpNamespace(), "\\")
+	}
+	if fd.GetPackage() == "" {
+		return ""
+	}
+	var segments []string
+	for _, s := range strings.Split(fd.GetPackage(), ".") {
+		segments = append(segments, phpReservedPrefix(strings.ToUpper(s[:1])+s[1:], fd))
+	}
+	return strings.Join(segments, "\\")
+}
+
+// phpReservedPrefix prefixes a class or namespace name that PHP reserves the
+// way protoc does: "GPB" in google.protobuf, "PB" elsewhere.
+func phpReservedPrefix(name string, fd *descriptorpb.FileDescriptorProto) string {
+	if !phpReservedNames[strings.ToLower(name)] {
+		return name
+	}
+	if fd.GetPackage() == "google.protobuf" {
+		return "GPB" + name
+	}
+	return "PB" + name
+}
+
+// collectPhpClassNames maps the fully-qualified name of every message in files
+// to its fully-qualified PHP class, e.g. ".pkg.Outer.Inner" ->
+// \Pkg\Outer\Inner.
+func collectPhpClassNames(files []*descriptorpb.FileDescriptorProto) map[string]string {
+	classes := make(map[string]string)
+	var walk func(fd *descriptorpb.FileDescriptorProto, class, prefix string, mds []*descriptorpb.DescriptorProto)
+	walk = func(fd *descriptorpb.FileDescriptorProto, class, prefix string, mds []*descriptorpb.DescriptorProto) {
+		for _, md := range mds {
+			name := fd.GetOptions().GetPhpClassPrefix() + phpReservedPrefix(md.GetName(), fd)
+			classes[prefix+"."+md.GetName()] = class + "\\" + name
+			walk(fd, class+"\\"+name, prefix+"."+md.GetName(), md.GetNestedType())
+		}
+	}
+	for _, fd := range files {
+		ns := ""
+		if n := phpNamespace(fd); n != "" {
+			ns = "\\" + n
+		}
+		walk(fd, ns, strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), "."), fd.GetMessageType())
 	}
-	return strings.Title(pkg)
+	return classes
 }
 
+// phpTypeName is the PHP class of proto type full as written in namespace ns:
+// relative when it is declared in ns or below, fully-qualified otherwise.
+// type_map does not apply, its targets are C# and JS types.
+func phpTypeName(full string, classes map[string]string, ns string) string {
+	class, ok := classes[full]
+	if !ok {
+		// not in the request: assume the package has no php_namespace
+		class = "\\" + strings.ReplaceAll(strings.TrimPrefix(full, "."), ".", "\\")
+	}
+	if ns == "" {
+		return strings.TrimPrefix(class, "\\")
+	}
+	if rel, ok := strings.CutPrefix(class, "\\"+ns+"\\"); ok {
+		return rel
+	}
+	return class
+}
+
+// phpReservedNames are the names protoc's PHP generator prefixes, compared
+// in lower case.
+var phpReservedNames = map[string]bool{
+	"abstract": true, "and": true, "array": true, "as": true, "break": true,
+	"callable": true, "case": true, "catch": true, "class": true, "clone": true,
+	"const": true, "continue": true, "declare": true, "default": true, "die": true,
+	"do": true, "echo": true, "else": true, "elseif": true, "empty": true,
+	"enddeclare": true, "endfor": true, "endforeach": true, "endif": true, "endswitch": true,
+	"endwhile": true, "eval": true, "exit": true, "extends": true, "final": true,
+	"finally": true, "fn": true, "for": true, "foreach": true, "function": true,
+	"global": true, "goto": true, "if": true, "implements": true, "include": true,
+	"include_once": true, "instanceof": true, "insteadof": true, "interface": true, "isset": true,
+	"list": true, "match": true, "namespace": true, "new": true, "or": true,
+	"parent": true, "print": true, "private": true, "protected": true, "public": true,
+	"readonly": true, "require": true, "require_once": true, "return": true, "self": true,
+	"static": true, "switch": true, "throw": true, "trait": true, "try": true,
+	"unset": true, "use": true, "var": true, "while": true, "xor": true,
+	"int": true, "float": true, "bool": true, "string": true, "true": true,
+	"false": true, "null": true, "void": true, "iterable": true, "object": true,
+	"mixed": true, "never": true,
+}
+
+// unindentNamespace removes the indentation level of the namespace block from
+// C# rendered without one (cs_no_namespace).
+func unindentNamespace(content string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, "    ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// fileScopeNamespace turns the namespace block of a rendered C# file into a
+// file-scoped declaration (cs_file_scoped_namespace), one indentation level
+// less. C# allows a single file-scoped namespace per file, so a file with
+// several namespace blocks fails the generation.
+func fileScopeNamespace(fileName, content string) string {
+	lines := strings.Split(content, "\n")
+	start := -1
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "namespace ") {
+			continue
+		}
+		if start >= 0 {
+			fail("cs_file_scoped_namespace: %s declares more than one namespace", fileName)
+		}
+		start = i
+	}
+	if start < 0 || start+1 >= len(lines) || lines[start+1] != "{" {
+		return content
+	}
+	end := len(lines) - 1
+	for end > start && lines[end] != "}" {
+		end--
+	}
+	out := append([]string{}, lines[:start]...)
+	out = append(out, lines[start]+";", "")
+	for _, line := range lines[start+2 : end] {
+		out = append(out, strings.TrimPrefix(line, "    "))
+	}
+	out = append(out, lines[end+1:]...)
+	return strings.Join(out, "\n")
+}
+
+// field numbers of the descriptor paths used to look up source comments
+const (
+	pathService = 6 // FileDescriptorProto.service
+	pathMethod  = 2 // ServiceDescriptorProto.method
+)
+
+// commentPath is the key of a descriptor in the map built by collectComments,
+// e.g. commentPath(pathService, 0, pathMethod, 1) for the second rpc of the
+// first service.
+func commentPath(path ...int32) string {
+	return fmt.Sprint(path)
+}
+
+// collectComments maps the paths of the commented declarations of fd to their
+// leading comment, with the comment markers' padding removed. protoc only
+// sends source info for the files to generate.
+func collectComments(fd *descriptorpb.FileDescriptorProto) map[string]string {
+	out := make(map[string]string)
+	for _, loc := range fd.GetSourceCodeInfo().GetLocation() {
+		if loc.LeadingComments == nil {
+			continue
+		}
+		lines := strings.Split(strings.TrimRight(loc.GetLeadingComments(), "\n"), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(strings.TrimPrefix(line, " "), " \t")
+		}
+		if c := strings.TrimSpace(strings.Join(lines, "\n")); c != "" {
+			out[commentPath(loc.GetPath()...)] = c
+		}
+	}
+	return out
+}
+
+// docLines splits a proto comment into the lines of a generated doc comment.
+func docLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// methodDoc is the doc comment of a client method: the leading comment of the
+// rpc, or a sentence naming the request and response types when it has none.
+func methodDoc(comment, inputType, outputType string) string {
+	if comment != "" {
+		return comment
+	}
+	return fmt.Sprintf("Sends %s %s and returns %s %s.", indefiniteArticle(inputType), inputType, indefiniteArticle(outputType), outputType)
+}
+
+// indefiniteArticle picks "a" or "an" by the first letter of word.
+func indefiniteArticle(word string) string {
+	if word != "" && strings.ContainsRune("AEIOUaeiou", rune(word[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// xmlDocEscaper escapes comment text for C# /// comments, which are XML.
+var xmlDocEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// jsDocEscape keeps comment text from closing the JSDoc block it is written into.
+func jsDocEscape(s string) string {
+	return strings.ReplaceAll(s, "*/", "*\\/")
+}
+
+// markdownCell flattens text onto one line for a markdown table cell.
+func markdownCell(s string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(s), " "), "|", "\\|")
+}
+
+// collectExtendedTypes returns the full names (with the leading dot) of the messages
+// extended by an extend block anywhere in files. Extension fields are declared apart
+// from the message fields, so the generated code never sees them.
+func collectExtendedTypes(files []*descriptorpb.FileDescriptorProto) map[string]bool {
+	extended := make(map[string]bool)
+	var walk func(msgs []*descriptorpb.DescriptorProto)
+	walk = func(msgs []*descriptorpb.DescriptorProto) {
+		for _, md := range msgs {
+			for _, ext := range md.GetExtension() {
+				extended[ext.GetExtendee()] = true
+			}
+			walk(md.GetNestedType())
+		}
+	}
+	for _, f := range files {
+		for _, ext := range f.GetExtension() {
+			extended[ext.GetExtendee()] = true
+		}
+		walk(f.GetMessageType())
+	}
+	return extended
+}
+
+// collectAllMessages returns the names of the top-level messages of fd in
+// declaration order: protoc lists them in the order of the .proto, and the
+// enums, services and nested messages declared between them are kept in
+// separate lists, so they do not change it. The message of a proto2 group is
+// nested in the message declaring the group, so groups add no entries here.
 func collectAllMessages(fd *descriptorpb.FileDescriptorProto) []string {
 	var out []string
 	for _, md := range fd.GetMessageType() {
@@ -275,12 +2066,861 @@ func collectAllMessages(fd *descriptorpb.FileDescriptorProto) []string {
 	return out
 }
 
-func renderTemplate(tmpl *template.Template, data interface{}) (string, error) {
-	var sb strings.Builder
-	if err := tmpl.Execute(&sb, data); err != nil {
-		return "", err
+// collectMessages returns the top-level messages of fd, each followed by the
+// messages nested in it when nested is set. Map entries are left out: they are
+// no messages of their own in the generated code.
+func collectMessages(fd *descriptorpb.FileDescriptorProto, jsNsSep, jsInt64 string, nested bool) []messageInfo {
+	var out []messageInfo
+	var walk func(outer *messageInfo, mds []*descriptorpb.DescriptorProto)
+	walk = func(outer *messageInfo, mds []*descriptorpb.DescriptorProto) {
+		for _, md := range mds {
+			if md.GetOptions().GetMapEntry() {
+				continue
+			}
+			msg := newMessageInfo(fd, outer, md, jsNsSep, jsInt64)
+			out = append(out, msg)
+			if nested {
+				walk(&msg, md.GetNestedType())
+			}
+		}
 	}
-	return sb.String(), nil
+	walk(nil, fd.GetMessageType())
+	return out
+}
+
+// newMessageInfo describes md, a message of fd nested in outer (nil for a
+// top-level message).
+func newMessageInfo(fd *descriptorpb.FileDescriptorProto, outer *messageInfo, md *descriptorpb.DescriptorProto, jsNsSep, jsInt64 string) messageInfo {
+	msg := messageInfo{Name: md.GetName(), CsName: md.GetName(), CsFlatName: md.GetName()}
+	if outer != nil {
+		msg.Name = outer.Name + "." + md.GetName()
+		msg.CsName = outer.CsName + ".Types." + md.GetName()
+		msg.CsFlatName = outer.CsFlatName + "_" + md.GetName()
+	}
+	msg.FullName = strings.TrimPrefix(qualifiedName(fd.GetPackage(), msg.Name), ".")
+	msg.JsName = jsTypeName(qualifiedName(fd.GetPackage(), msg.Name), jsNsSep)
+	msg.JsFlatName = msg.JsName
+	if jsNsSep == "" {
+		msg.JsFlatName = msg.CsFlatName
+	}
+	oneofs := make([]oneofInfo, len(md.GetOneofDecl()))
+	for i, od := range md.GetOneofDecl() {
+		oneofs[i] = oneofInfo{
+			Name:     od.GetName(),
+			JsonName: lowerCamelCase(od.GetName()),
+			TypeName: msg.JsName + toPascalCase(od.GetName()),
+		}
+	}
+	// only declared fields: reserved numbers and names are kept apart in
+	// ReservedRange/ReservedName and must not show up as fields
+	for _, f := range md.GetField() {
+		fi := fieldInfo{
+			Name:     f.GetName(),
+			JsonName: jsonName(f),
+			Number:   f.GetNumber(),
+			TsType:   tsFieldType(f, jsNsSep, jsInt64),
+			TypeName: strings.TrimPrefix(f.GetTypeName(), "."),
+
+			IsMessage:   f.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || f.GetType() == descriptorpb.FieldDescriptorProto_TYPE_GROUP,
+			HasPresence: hasPresence(fd, f),
+			Deprecated:  f.GetOptions().GetDeprecated(),
+		}
+		fi.JsZero = jsZeroValue(f, md, jsInt64, fi.HasPresence)
+		msg.Fields = append(msg.Fields, fi)
+		// proto3 "optional" fields live in synthetic oneofs, which are not real unions
+		if f.OneofIndex != nil && !f.GetProto3Optional() {
+			idx := f.GetOneofIndex()
+			oneofs[idx].Fields = append(oneofs[idx].Fields, fi)
+		}
+	}
+	for _, o := range oneofs {
+		if len(o.Fields) > 0 {
+			msg.Oneofs = append(msg.Oneofs, o)
+		}
+	}
+	return msg
+}
+
+// collectEnums returns the top-level enums of fd followed by the enums nested
+// in its messages.
+func collectEnums(fd *descriptorpb.FileDescriptorProto, jsNsSep string) []enumInfo {
+	var out []enumInfo
+	add := func(prefix, csPrefix string, eds []*descriptorpb.EnumDescriptorProto) {
+		for _, ed := range eds {
+			e := enumInfo{
+				Name:       ed.GetName(),
+				FullName:   strings.TrimPrefix(prefix+"."+ed.GetName(), "."),
+				JsName:     jsTypeName(prefix+"."+ed.GetName(), jsNsSep),
+				CsName:     csPrefix + ed.GetName(),
+				AllowAlias: ed.GetOptions().GetAllowAlias(),
+			}
+			seen := make(map[int32]bool)
+			for _, v := range ed.GetValue() {
+				val := enumValueInfo{Name: v.GetName(), Number: v.GetNumber()}
+				e.Values = append(e.Values, val)
+				if !seen[val.Number] {
+					seen[val.Number] = true
+					e.UniqueValues = append(e.UniqueValues, val)
+				}
+			}
+			out = append(out, e)
+		}
+	}
+	add(strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), "."), "", fd.GetEnumType())
+
+	// C# nests the types of a message in its Types class
+	var walk func(prefix, csPrefix string, mds []*descriptorpb.DescriptorProto)
+	walk = func(prefix, csPrefix string, mds []*descriptorpb.DescriptorProto) {
+		for _, md := range mds {
+			name := prefix + "." + md.GetName()
+			csName := csPrefix + md.GetName() + ".Types."
+			add(name, csName, md.GetEnumType())
+			walk(name, csName, md.GetNestedType())
+		}
+	}
+	walk(strings.TrimSuffix(qualifiedName(fd.GetPackage(), ""), "."), "", fd.GetMessageType())
+	return out
+}
+
+func hasOneofs(messages []messageInfo) bool {
+	for _, m := range messages {
+		if len(m.Oneofs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// tsFieldType is the JS/TS type of a field's value; jsInt64 ("number", "string"
+// or "bigint") is the type of 64-bit integers.
+func tsFieldType(f *descriptorpb.FieldDescriptorProto, jsNsSep, jsInt64 string) string {
+	var t string
+	switch f.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		t = "string"
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		t = "boolean"
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		t = "Uint8Array"
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		// strings by default, like protobuf JSON; numbers lose precision past 2^53
+		t = jsInt64
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptorpb.FieldDescriptorProto_TYPE_GROUP, // proto2 groups name their nested message
+		descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		t = jsTypeName(f.GetTypeName(), jsNsSep)
+	default:
+		t = "number"
+	}
+	if f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		t += "[]"
+	}
+	return t
+}
+
+// jsZeroValue is the JS literal a new message object of md starts f with,
+// like the fromObject defaults of protobufjs: empty arrays and maps, zero
+// scalars, first enum number 0. Fields with presence stay unset (""), as
+// setting them would send them, except proto2 required ones, which must be.
+func jsZeroValue(f *descriptorpb.FieldDescriptorProto, md *descriptorpb.DescriptorProto, jsInt64 string, presence bool) string {
+	if f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		for _, nested := range md.GetNestedType() {
+			if nested.GetOptions().GetMapEntry() && strings.HasSuffix(f.GetTypeName(), "."+nested.GetName()) {
+				return "{}"
+			}
+		}
+		return "[]"
+	}
+	if presence && f.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REQUIRED {
+		return ""
+	}
+	switch f.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		return `""`
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return "false"
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return "new Uint8Array(0)"
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		switch jsInt64 {
+		case "string":
+			return `"0"`
+		case "bigint":
+			return "0n"
+		}
+		return "0"
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptorpb.FieldDescriptorProto_TYPE_GROUP:
+		return ""
+	}
+	return "0"
+}
+
+// hasPresence reports whether f tells "set to the default" from "unset",
+// which depends on the syntax of fd, the file declaring it: every singular
+// field in proto2, only message, oneof and "optional" fields in proto3, the
+// field_presence feature in editions. A request may mix files of each.
+func hasPresence(fd *descriptorpb.FileDescriptorProto, f *descriptorpb.FieldDescriptorProto) bool {
+	if f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return false
+	}
+	if f.OneofIndex != nil || f.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || f.GetType() == descriptorpb.FieldDescriptorProto_TYPE_GROUP {
+		return true
+	}
+	switch fd.GetSyntax() {
+	case "proto3":
+		return false
+	case "editions":
+		presence := fd.GetOptions().GetFeatures().GetFieldPresence()
+		if p := f.GetOptions().GetFeatures().GetFieldPresence(); p != descriptorpb.FeatureSet_FIELD_PRESENCE_UNKNOWN {
+			presence = p
+		}
+		return presence != descriptorpb.FeatureSet_IMPLICIT
+	}
+	return true // "proto2", or "" which protoc sends for proto2
+}
+
+// jsonName is the JSON name of a field: its json_name, which protoc sets to the
+// explicit [json_name = "..."] or else to the lowerCamelCase of the name, and the
+// same default computed here for descriptors from tools that leave it unset.
+func jsonName(f *descriptorpb.FieldDescriptorProto) string {
+	if f.JsonName != nil {
+		return f.GetJsonName()
+	}
+	return lowerCamelCase(f.GetName())
+}
+
+// lowerCamelCase drops the underscores of a proto name and capitalizes the
+// letters that followed them, e.g. "user_id" -> "userId".
+func lowerCamelCase(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func toPascalCase(s string) string {
+	// e.g. "my_oneof" -> "MyOneof"
+	var sb strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return sb.String()
+}
+
+// endpointExpr is the expression a client passes to the transport as the name
+// of method: the "Service.Method" literal, or with gen_base_url a call of the
+// client's endpoint helper, self being "this." in JS/TS and "" in C#.
+func endpointExpr(baseUrl bool, self, service, method string) string {
+	if !baseUrl {
+		return fmt.Sprintf("%q", service+"."+method)
+	}
+	if self == "" {
+		return fmt.Sprintf("Endpoint(%q)", method)
+	}
+	return fmt.Sprintf("%sendpoint(%q)", self, method)
+}
+
+func hasServerStreaming(methods []methodInfo) bool {
+	for _, m := range methods {
+		if m.ServerStreaming {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAuthMethods(methods []methodInfo) bool {
+	for _, m := range methods {
+		if m.RequireAuth {
+			return true
+		}
+	}
+	return false
+}
+
+func hasCachedMethods(methods []methodInfo) bool {
+	for _, m := range methods {
+		if m.Cached {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDedupedMethods(methods []methodInfo) bool {
+	for _, m := range methods {
+		if m.Deduped {
+			return true
+		}
+	}
+	return false
+}
+
+func hasOptimisticMethods(methods []methodInfo) bool {
+	for _, m := range methods {
+		if m.Optimistic {
+			return true
+		}
+	}
+	return false
+}
+
+// resultType wraps a client result type in format when wrap is set,
+// e.g. "HelloReply" -> "TracedResponse<HelloReply>".
+func resultType(outputType, format string, wrap bool) string {
+	if !wrap {
+		return outputType
+	}
+	return fmt.Sprintf(format, outputType)
+}
+
+var filenamePlaceholderRe = regexp.MustCompile(`\{[^{}]*\}`)
+
+// expandFilenamePattern names the output of target t for a service following
+// filename_pattern, e.g. "{service}/{service}.{lang}.{role}{ext}" ->
+// "Greeter/Greeter.ts.client.ts".
+func expandFilenamePattern(pattern, baseName, svcName string, t genTarget) string {
+	return strings.NewReplacer(
+		"{proto}", baseName,
+		"{service}", svcName,
+		"{lang}", t.lang,
+		"{role}", t.role,
+		"{ext}", "."+t.lang,
+	).Replace(pattern)
+}
+
+// relativeImportPath is the JS/TS module specifier of file "to" imported from
+// file "from", both relative to the output root and without extension.
+func relativeImportPath(from, to string) string {
+	rel, err := filepath.Rel(filepath.Dir(from), to)
+	if err != nil {
+		return "./" + filepath.Base(to)
+	}
+	rel = filepath.ToSlash(rel)
+	if !strings.HasPrefix(rel, "../") {
+		rel = "./" + rel
+	}
+	return rel
+}
+
+// singleFileName is the single_file output of a proto for lang, which holds
+// the clients and servers of all its services.
+func singleFileName(baseName, lang string) string {
+	return fmt.Sprintf("%s_webviewrpc.%s", baseName, lang)
+}
+
+// newFacadeClient lists the client of svcName, generated into the C#
+// namespace ns and the JS/TS module importPath (empty for C# only lists).
+func newFacadeClient(svcName, ns, importPath string) facadeClient {
+	csPrefix := "global::"
+	if ns != "" {
+		csPrefix += ns + "."
+	}
+	return facadeClient{
+		ServiceName:     svcName,
+		CsClassName:     csPrefix + svcName + "Client",
+		CsInterfaceName: csPrefix + "I" + svcName + "Client",
+		ImportPath:      importPath,
+	}
+}
+
+// runtimeImportPath points from a generated file back to the runtime file at
+// the output root, e.g. "api/hello" -> "../webviewrpc_runtime".
+func runtimeImportPath(baseName string) string {
+	depth := strings.Count(filepath.ToSlash(baseName), "/")
+	if depth == 0 {
+		return "./webviewrpc_runtime"
+	}
+	return strings.Repeat("../", depth) + "webviewrpc_runtime"
+}
+
+// checkJsMethodNames fails when two rpcs of a service generate the same JS/TS
+// client method, e.g. "Get" with gen_raw_overload next to an rpc "GetRaw", or
+// when a rename_map shim takes the name of one.
+func checkJsMethodNames(svcName string, methods []methodInfo, rawOverload bool, shims []renameShim) {
+	seen := make(map[string]string) // client method -> rpc it was generated for
+	for _, m := range methods {
+		names := []string{m.MethodName}
+		if rawOverload && !m.ServerStreaming {
+			names = append(names, m.MethodName+"Raw")
+		}
+		if m.Optimistic {
+			names = append(names, m.MethodName+"Optimistic")
+		}
+		for _, name := range names {
+			if rpc, ok := seen[name]; ok {
+				fail("service %s: rpcs %s and %s both generate the JS/TS client method %s", svcName, rpc, m.MethodName, name)
+			}
+			seen[name] = m.MethodName
+		}
+	}
+	for _, s := range shims {
+		if rpc, ok := seen[s.OldName]; ok {
+			fail("service %s: rename_map shim %s clashes with the JS/TS client method generated for rpc %s", svcName, s.OldName, rpc)
+		}
+	}
+}
+
+// checkServiceClassNames fails when a request/response type of a service is
+// referenced by the name of a class generated for it, e.g. a message
+// "GreeterClient" (or "Greeter" of another package, in JS/TS) used by service
+// Greeter: the reference would resolve to the generated class, or declare it
+// twice. C# types of other namespaces are qualified and cannot clash.
+func checkServiceClassNames(svcName string, methods []methodInfo, jsClasses, csClasses []string) {
+	for _, m := range methods {
+		for _, t := range []struct{ name, proto string }{{m.JsInputType, m.ProtoInputType}, {m.JsOutputType, m.ProtoOutputType}} {
+			if contains(jsClasses, t.name) {
+				fail("service %s: message %s is named %s in JS/TS like a class generated for the service; rename it or set js_ns_sep", svcName, t.proto, t.name)
+			}
+		}
+		for _, t := range []struct{ name, proto string }{{m.InputType, m.ProtoInputType}, {m.OutputType, m.ProtoOutputType}} {
+			if contains(csClasses, t.name) {
+				fail("service %s: message %s is named %s in C# like a class generated for the service; rename it or move it to another csharp_namespace", svcName, t.proto, t.name)
+			}
+		}
+	}
+}
+
+// resolveCsMethodNames sets the C# names of the rpcs of a service. With
+// cs_method_case=pascal they are converted to PascalCase ("get_user" ->
+// "GetUser"), keeping the capitals of names such as "GetHTTPStatus"; rpcs
+// ending up with the same name fail.
+//
+// It then handles rpcs whose C# members would be named like their enclosing
+// class, which C# rejects (CS0542): "GreeterClient" in the client
+// GreeterClient, or "GreeterBase" in the server base GreeterBase. Likewise an
+// rpc "ServiceName" would be declared twice in the client, next to its
+// ServiceName constant. With method_name_collision=error it fails, with rename
+// the C# identifier gets a trailing underscore, as protoc does for such
+// members. Wire names are unchanged. Suffixed members (<Method>Async,
+// <Method>Sync, ...) cannot collide, the class names end in Client and Base.
+//
+// A service named ServiceName fails with a C# server: its static class
+// ServiceName would hold the ServiceName constant.
+func resolveCsMethodNames(svcName string, methods []methodInfo, client, server, streamCallback, pascal bool, mode string) {
+	if server && svcName == "ServiceName" {
+		fail("service %s: its C# server class %s would declare the constant ServiceName, named like the class; rename the service", svcName, svcName)
+	}
+	taken := make(map[string]string) // C# name -> rpc
+	for i := range methods {
+		m := &methods[i]
+		// "_1st" keeps its name, "1st" is no identifier
+		if name := toPascalCase(m.MethodName); pascal && name != "" && !unicode.IsDigit(rune(name[0])) {
+			m.CsMethodName = name
+		}
+		if rpc, ok := taken[m.CsMethodName]; ok {
+			fail("service %s: rpcs %s and %s both generate the C# method %s; rename one of them or set cs_method_case=preserve", svcName, rpc, m.MethodName, m.CsMethodName)
+		}
+		taken[m.CsMethodName] = m.MethodName
+	}
+	for i := range methods {
+		m := &methods[i]
+		plainClient := client && !(m.ServerStreaming && !streamCallback)
+		var clash string
+		switch {
+		case plainClient && m.CsMethodName == svcName+"Client":
+			clash = "its generated class " + svcName + "Client"
+		case server && m.CsMethodName == svcName+"Base":
+			clash = "its generated class " + svcName + "Base"
+		case plainClient && m.CsMethodName == "ServiceName":
+			clash = "the ServiceName constant of " + svcName + "Client"
+		default:
+			continue
+		}
+		if mode == "error" {
+			fail("service %s: rpc %s is named %s in C#, like %s; rename the rpc or set method_name_collision=rename", svcName, m.MethodName, m.CsMethodName, clash)
+		}
+		m.CsMethodName += "_"
+		if rpc, ok := taken[m.CsMethodName]; ok {
+			fail("service %s: rpc %s cannot be renamed to %s in C#, rpc %s has that name", svcName, m.MethodName, m.CsMethodName, rpc)
+		}
+	}
+}
+
+// checkJsTypeNames fails when two request/response messages declared in one
+// JS/TS file get the same type name, e.g. "a.Update" and "b.Update" without
+// js_ns_sep: the file would declare and encode both as one type. A message
+// used by several methods or services is declared once and is no conflict,
+// neither are messages mapped to the same type_map target.
+func checkJsTypeNames(seen map[string]string, methods []methodInfo, typeMap map[string]string) {
+	for _, m := range methods {
+		for _, t := range []struct{ js, proto string }{{m.JsInputType, m.ProtoInputType}, {m.JsOutputType, m.ProtoOutputType}} {
+			id := t.proto
+			if target := typeMap["."+t.proto]; target != "" {
+				id = target
+			}
+			if prev, ok := seen[t.js]; ok && prev != id {
+				fail("%s and %s are both named %s in JS/TS; set js_ns_sep to tell them apart", prev, id, t.js)
+			}
+			seen[t.js] = id
+		}
+	}
+}
+
+// reflectionJSON lists the methods of a service for its "$reflect" handler.
+func reflectionJSON(svcName string, methods []methodInfo) string {
+	entries := []reflectionMethod{}
+	for _, m := range methods {
+		entries = append(entries, reflectionMethod{
+			Method:          svcName + "." + m.MethodName,
+			InputType:       m.ProtoInputType,
+			OutputType:      m.ProtoOutputType,
+			ServerStreaming: m.ProtoServerStreaming,
+		})
+	}
+	out, _ := json.Marshal(entries) // plain strings and bools, cannot fail
+	return string(out)
+}
+
+func hasTimeouts(methods []methodInfo) bool {
+	for _, m := range methods {
+		if m.TimeoutMs > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// collectClientRuntimeImports lists the runtime helpers a JS or TS ("js",
+// "ts") client uses.
+func collectClientRuntimeImports(svc serviceInfo, lang string) []string {
+	var out []string
+	if svc.GenTrace {
+		out = append(out, "newTraceId", "withTraceId")
+	}
+	if svc.HasTimeouts {
+		out = append(out, "withTimeout")
+	}
+	if svc.GenBatch && lang == "js" {
+		out = append(out, "encodeBatchCalls", "decodeBatchResults")
+		if svc.HasTimeouts {
+			out = append(out, "RpcTimeoutError")
+		}
+	}
+	if svc.GenEnvelope {
+		out = append(out, "newRequestId", "encodeEnvelope", "openEnvelope")
+	}
+	if svc.GenCancel {
+		out = append(out, "RpcCancelledError")
+	}
+	if svc.GenSerializer {
+		out = append(out, "protobufSerializer")
+	}
+	if svc.StreamPoll {
+		out = append(out, "pollStream")
+	}
+	if svc.GenBackpressure {
+		out = append(out, "subscribeWithBackpressure", "encodeFlowControl")
+	}
+	if svc.GenInterceptors {
+		out = append(out, "runInterceptors")
+	}
+	if svc.GenOtel {
+		out = append(out, "withSpan")
+	}
+	if svc.GenLogHook {
+		out = append(out, "withLogHook")
+	}
+	if svc.GenOfflineQueue {
+		out = append(out, "OfflineQueue", "transportConnected")
+	}
+	if svc.WsReconnect {
+		out = append(out, "reconnectTransport")
+	}
+	if svc.GzipCompression {
+		out = append(out, "compressEnvelope", "decompressEnvelope")
+	}
+	return out
+}
+
+// collectServerRuntimeImports lists the runtime helpers imported by the JS/TS
+// server of svc.
+func collectServerRuntimeImports(svc serviceInfo) []string {
+	if svc.GzipCompression {
+		return []string{"decodeEnvelope", "encodeEnvelope", "encodeErrorEnvelope", "decompressEnvelope", "compressEnvelopeLike"}
+	}
+	if svc.GenEnvelope {
+		return []string{"decodeEnvelope", "encodeEnvelope", "encodeErrorEnvelope"}
+	}
+	return nil
+}
+
+// collectTsRuntimeImports lists the runtime types and helpers imported by the
+// TS client and server of svc.
+func collectTsRuntimeImports(svc serviceInfo) (client, server []string) {
+	if svc.GenTrace {
+		client = append(client, "Traced")
+	}
+	if svc.GenMetadata {
+		client = append(client, "RpcMetadata")
+		server = append(server, "RpcCallContext", "RpcMetadata")
+	}
+	if svc.GenSerializer {
+		client = append(client, "RpcSerializer")
+	}
+	if svc.GenBackpressure {
+		client = append(client, "RpcSubscription")
+	}
+	if svc.GenSign {
+		client = append(client, "RpcSigner")
+	}
+	if svc.SchemaVersion != "" {
+		client = append(client, "RpcCallEnvelope")
+	}
+	if svc.GenInterceptors {
+		client = append(client, "RpcInterceptor")
+	}
+	if svc.GenOtel {
+		client = append(client, "RpcTracer")
+	}
+	if svc.GenLogHook {
+		client = append(client, "RpcLogHook", "RpcSensitiveFields")
+	}
+	if svc.GenOfflineQueue {
+		client = append(client, "RpcQueueStorage")
+	}
+	return append(client, collectClientRuntimeImports(svc, "ts")...), append(server, collectServerRuntimeImports(svc)...)
+}
+
+func collectTypeNames(methods []methodInfo) []string {
+	var out []string
+	for _, m := range methods {
+		for _, t := range []string{m.JsInputType, m.JsOutputType} {
+			if !contains(out, t) {
+				out = append(out, t)
+			}
+		}
+	}
+	return out
+}
+
+// collectTsInterfaces returns the types a TS client declares, each once: the
+// request/response types of typeNames, then for the oneof unions the messages
+// of the proto with oneofs and the types of their members, except the enums
+// the proto declares itself.
+func collectTsInterfaces(typeNames []string, messages []messageInfo, enums []enumInfo) (types, oneofTypes []tsInterfaceInfo) {
+	oneofs := make(map[string][]oneofInfo)
+	for _, m := range messages {
+		if len(m.Oneofs) > 0 {
+			oneofs[m.JsName] = m.Oneofs
+		}
+	}
+	declared := make(map[string]bool)
+	for _, e := range enums {
+		declared[e.JsName] = true
+	}
+	for _, t := range typeNames {
+		declared[t] = true
+		types = append(types, tsInterfaceInfo{Name: t, Oneofs: oneofs[t]})
+	}
+	add := func(t tsInterfaceInfo) {
+		if !declared[t.Name] {
+			declared[t.Name] = true
+			oneofTypes = append(oneofTypes, t)
+		}
+	}
+	for _, m := range messages {
+		if len(m.Oneofs) > 0 {
+			add(tsInterfaceInfo{Name: m.JsName, Oneofs: m.Oneofs})
+		}
+	}
+	for _, m := range messages {
+		for _, o := range m.Oneofs {
+			for _, f := range o.Fields {
+				if f.TypeName != "" {
+					add(tsInterfaceInfo{Name: f.TsType, Enum: !f.IsMessage})
+				}
+			}
+		}
+	}
+	return types, oneofTypes
+}
+
+// collectTypedefs returns the request/response messages of methods found in
+// byName and the messages and enums reachable through their fields, in order
+// of first use. type_map targets are hand-written and skipped.
+func collectTypedefs(methods []methodInfo, byName map[string]messageInfo, enumsByName map[string]enumInfo, typeMap map[string]string) ([]messageInfo, []enumInfo) {
+	var out []messageInfo
+	var enums []enumInfo
+	seen := make(map[string]bool)
+	var visit func(t string)
+	visit = func(t string) {
+		if seen[t] || typeMap["."+t] != "" {
+			return
+		}
+		if e, ok := enumsByName[t]; ok {
+			seen[t] = true
+			enums = append(enums, e)
+			return
+		}
+		msg, ok := byName[t]
+		if !ok {
+			return
+		}
+		seen[t] = true
+		out = append(out, msg)
+		// follow the fields, so the messages and enums (nested ones too) their
+		// @property types name are documented as well
+		for _, f := range msg.Fields {
+			if f.TypeName != "" {
+				visit(f.TypeName)
+			}
+		}
+	}
+	for _, m := range methods {
+		visit(m.ProtoInputType)
+		visit(m.ProtoOutputType)
+	}
+	return out, enums
+}
+
+// collectCodecImports lists the JS/TS codec functions used for the given
+// methods, e.g. "encode"/"decode" -> encodeHelloRequest, decodeHelloReply.
+func collectCodecImports(methods []methodInfo, inputPrefix, outputPrefix string) []string {
+	var out []string
+	for _, m := range methods {
+		for _, fn := range []string{inputPrefix + m.JsInputType, outputPrefix + m.JsOutputType} {
+			if !contains(out, fn) {
+				out = append(out, fn)
+			}
+		}
+	}
+	return out
+}
+
+var jsImportRe = regexp.MustCompile(`^import \{ (.*) \} from (.+);$`)
+
+// mergeImportLines drops duplicate using/import lines and merges JS/TS named
+// imports into one statement per module. A name already imported from another
+// module is skipped, since every module exports the same message codecs.
+func mergeImportLines(lines []string) []string {
+	var out []string
+	var bound []string
+	moduleLine := make(map[string]int)
+	for _, line := range lines {
+		line = strings.TrimRight(line, " ")
+		if line == "" {
+			continue
+		}
+		m := jsImportRe.FindStringSubmatch(line)
+		if m == nil {
+			if !contains(out, line) {
+				out = append(out, line)
+			}
+			continue
+		}
+		var names []string
+		if i, ok := moduleLine[m[2]]; ok {
+			names = strings.Split(jsImportRe.FindStringSubmatch(out[i])[1], ", ")
+		}
+		added := false
+		for _, name := range strings.Split(m[1], ", ") {
+			if !contains(bound, name) {
+				bound = append(bound, name)
+				names = append(names, name)
+				added = true
+			}
+		}
+		if !added {
+			continue
+		}
+		merged := fmt.Sprintf("import { %s } from %s;", strings.Join(names, ", "), m[2])
+		if i, ok := moduleLine[m[2]]; ok {
+			out[i] = merged
+		} else {
+			moduleLine[m[2]] = len(out)
+			out = append(out, merged)
+		}
+	}
+	return out
+}
+
+// renderedBytesPerMethod estimates the output of a service template per
+// method, to size the builder once: services with hundreds of methods render
+// to hundreds of kilobytes per file.
+const renderedBytesPerMethod = 512
+
+// growForService pre-sizes sb when data is a service.
+func growForService(sb *strings.Builder, data interface{}) {
+	if svc, ok := data.(serviceInfo); ok {
+		sb.Grow(len(svc.Methods) * renderedBytesPerMethod)
+	}
+}
+
+func renderTemplate(tmpl *template.Template, data interface{}) (string, error) {
+	var sb strings.Builder
+	growForService(&sb, data)
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// formatWithCommand pipes content through an external formatter (stdin ->
+// stdout). On any failure the unformatted content is kept and a warning is
+// printed, formatting never fails the generation.
+func formatWithCommand(cmdArgs []string, fileName, content string) string {
+	cmd := exec.Command(cmdArgs[0], cmdArgs[1:]...)
+	cmd.Stdin = strings.NewReader(content)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = fmt.Errorf("%v: %s", err, msg)
+		}
+		warn("formatter %q failed on %s, keeping unformatted output: %v", cmdArgs[0], fileName, err)
+		return content
+	}
+	return string(out)
+}
+
+func normalizeEOL(content, eol string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if eol == "crlf" {
+		content = strings.ReplaceAll(content, "\n", "\r\n")
+	}
+	return content
+}
+
+func renderSection(tmpl *template.Template, name string, data interface{}) (string, error) {
+	var sb strings.Builder
+	if name == "body" {
+		growForService(&sb, data)
+	}
+	if err := tmpl.ExecuteTemplate(&sb, name, data); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func addFile(resp *pluginpb.CodeGeneratorResponse, name, content string) {
+	resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
+		Name:    &name,
+		Content: &content,
+	})
 }
 
 func appendError(resp *pluginpb.CodeGeneratorResponse, msg string) {
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestParseGeneratorParams(t *testing.T) {
+	b64 := func(s string) string { return "b64:" + base64.StdEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		param string
+		want  map[string]string
+	}{
+		{"", map[string]string{}},
+		{"cs_client, js_client", map[string]string{"cs_client": "true", "js_client": "true"}},
+		{"cs_client,cs_transport_method=InvokeAsync", map[string]string{"cs_client": "true", "cs_transport_method": "InvokeAsync"}},
+		{"cs_transport_method=" + b64("InvokeAsync"), map[string]string{"cs_transport_method": "InvokeAsync"}},
+		{"js_client,js_transport_method=" + b64("call,Method=1"), map[string]string{"js_client": "true", "js_transport_method": "call,Method=1"}},
+	}
+	for _, tt := range tests {
+		if got := parseGeneratorParams(tt.param); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseGeneratorParams(%q) = %v, want %v", tt.param, got, tt.want)
+		}
+	}
+}
+
+// largeServiceRequest is a request for every target of one service with n
+// unary methods.
+func largeServiceRequest(n int) *pluginpb.CodeGeneratorRequest {
+	svc := &descriptorpb.ServiceDescriptorProto{Name: proto.String("Big")}
+	for i := 0; i < n; i++ {
+		svc.Method = append(svc.Method, &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(fmt.Sprintf("Method%d", i)),
+			InputType:  proto.String(".big.Req"),
+			OutputType: proto.String(".big.Resp"),
+		})
+	}
+	fd := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("big.proto"),
+		Package: proto.String("big"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Req")},
+			{Name: proto.String("Resp")},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{svc},
+	}
+	return &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"big.proto"},
+		Parameter:      proto.String("cs_client,cs_server,js_client,js_server,ts_client,ts_server"),
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{fd},
+	}
+}
+
+func TestGenerateLargeService(t *testing.T) {
+	start := time.Now()
+	files, stderr := runPlugin(t, largeServiceRequest(500))
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("generating 500 methods took %v", elapsed)
+	}
+	if stderr != "" {
+		t.Fatalf("plugin failed: %s", stderr)
+	}
+	if len(files) != 6 {
+		t.Fatalf("generated %d files, want 6", len(files))
+	}
+	for name, content := range files {
+		if !strings.Contains(content, "Method499") {
+			t.Errorf("%s is missing the last method", name)
+		}
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	req := largeServiceRequest(500)
+	for i := 0; i < b.N; i++ {
+		if _, stderr := runPlugin(b, req); stderr != "" {
+			b.Fatalf("plugin failed: %s", stderr)
+		}
+	}
+}
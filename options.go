@@ -0,0 +1,479 @@
+package main
+
+import (
+	"strings"
+	"text/template"
+
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// -------------------- Generator options --------------------
+
+// generatorOptions holds the parameters of a run, e.g.
+// "cs_client,cs_transport_method=InvokeAsync", parsed by parseGeneratorOptions
+// and checked by validate.
+type generatorOptions struct {
+	params map[string]string // as given, to tell defaults from explicit values
+
+	// targets, enabled when any file of the run has them; see forFile
+	genCSClient  bool
+	genCSServer  bool
+	genJSClient  bool
+	genJSServer  bool
+	genTSClient  bool
+	genTSServer  bool
+	genPHPClient bool
+
+	// (webviewrpc.targets): file name -> targets the file lists
+	fileTargets map[string]map[string]bool
+
+	// <lang>_<role>_only: "<lang>_<role>" -> services to generate, nil for all
+	serviceFilters map[string]map[string]bool
+
+	// naming and types
+	csTransportMethod     string
+	jsTransportMethod     string
+	csRawTransportMethod  string
+	csProtobufNs          string
+	csNamespace           string
+	csNamespaceSuffix     string
+	csNoNamespace         bool
+	csFileScopedNamespace bool
+	csAccess              string
+	csMethodCase          string
+	methodNameCollision   string
+	typeMap               map[string]string
+	renameMap             map[string]string
+	jsInt64               string
+	jsNsSep               string
+	jsTypedefs            bool
+
+	// client features
+	genCache             bool
+	cacheTtlMs           int
+	genDedupe            bool
+	genOptimistic        bool
+	genTrace             bool
+	genMetadata          bool
+	genRawOverload       bool
+	tsGenInterface       bool
+	genConnectionEvents  bool
+	csGenSyncWrapper     bool
+	csArgChecks          bool
+	genBatch             bool
+	genReflection        bool
+	genStreaming         bool
+	genStreamManager     bool
+	csStreamStyle        string
+	streamFallback       string
+	streamPollIntervalMs int
+	genBackpressure      bool
+	genBaseUrl           bool
+	genExposeTransport   bool
+	genInterceptors      bool
+	genOtel              bool
+	genLogHook           bool
+	genOfflineQueue      bool
+	wsReconnect          bool
+	wsReconnectMax       int
+	wsReconnectBackoffMs int
+	defaultTimeoutMs     int
+	maxPayloadBytes      int
+	maxConcurrent        int
+	csMethodPrologue     *template.Template
+	csMethodEpilogue     *template.Template
+
+	// envelope
+	genEnvelope    bool
+	genCancel      bool
+	genSign        bool
+	genSerializer  bool
+	schemaVersion  string
+	schemaMismatch string
+	compression    string
+
+	// additional files
+	genReport          bool
+	singleFile         bool
+	genClientFactory   bool
+	genFacade          bool
+	csGenDiExtensions  bool
+	csDiLifetime       string
+	genMarkdown        bool
+	genFieldNumbers    bool
+	genEnumNames       bool
+	genMessageRegistry bool
+	genTests           bool
+	genJSONSchema      bool
+	genExamples        bool
+	jsonEnum           string
+
+	// output files
+	filenamePattern string
+	maxFilenameLen  int
+	csFormatCmd     []string
+	eol             string
+}
+
+// parseGeneratorOptions reads the parameters of req, with the targets of its
+// (webviewrpc.targets) options. Values that cannot be read, such as a
+// malformed integer or an unknown service, fail the run.
+func parseGeneratorOptions(req *pluginpb.CodeGeneratorRequest) *generatorOptions {
+	params := parseGeneratorParams(req.GetParameter())
+	o := &generatorOptions{params: params, fileTargets: collectFileTargets(req.ProtoFile, req.FileToGenerate)}
+	o.setTargets(func(target string) bool {
+		if v, set := params[target]; set {
+			return v == "true"
+		}
+		for _, listed := range o.fileTargets {
+			if listed[target] {
+				return true
+			}
+		}
+		return false
+	})
+
+	serviceNames := make(map[string]bool)
+	for _, fd := range req.ProtoFile {
+		if contains(req.FileToGenerate, fd.GetName()) {
+			for _, svc := range fd.GetService() {
+				serviceNames[svc.GetName()] = true
+			}
+		}
+	}
+	for k, v := range params {
+		if v == "true" && serviceNames[k] {
+			fail("%s is not an option: list several services by repeating the option, e.g. cs_server_only=A,cs_server_only=B", k)
+		}
+	}
+	o.serviceFilters = make(map[string]map[string]bool)
+	for _, lang := range []string{"cs", "js", "ts"} {
+		for _, role := range []string{"client", "server"} {
+			key := lang + "_" + role + "_only"
+			if params[key] == "" {
+				continue
+			}
+			filter := make(map[string]bool)
+			for _, name := range strings.FieldsFunc(params[key], func(r rune) bool { return r == ',' || r == '\n' }) {
+				name = strings.TrimSpace(name)
+				if !serviceNames[name] {
+					fail("invalid %s: no service %s in the request", key, name)
+				}
+				filter[name] = true
+			}
+			o.serviceFilters[lang+"_"+role] = filter
+		}
+	}
+
+	o.csTransportMethod = paramOrDefault(params, "cs_transport_method", "CallMethod")
+	o.jsTransportMethod = paramOrDefault(params, "js_transport_method", "callMethod")
+	o.csRawTransportMethod = paramOrDefault(params, "cs_raw_transport_method", "CallMethodRaw")
+	o.csProtobufNs = paramOrDefault(params, "cs_protobuf_ns", "Google.Protobuf")
+	o.csNamespace = params["cs_namespace"]
+	o.csNamespaceSuffix = strings.TrimPrefix(params["cs_namespace_suffix"], ".")
+	o.csNoNamespace = params["cs_no_namespace"] == "true"
+	o.csFileScopedNamespace = params["cs_file_scoped_namespace"] == "true"
+	o.csAccess = paramOrDefault(params, "cs_access", "public")
+	o.csMethodCase = paramOrDefault(params, "cs_method_case", "pascal")
+	o.methodNameCollision = paramOrDefault(params, "method_name_collision", "error")
+	o.typeMap = parseTypeMap(params["type_map"], req.ProtoFile)
+	o.renameMap = parseRenameMap(params["rename_map"])
+	o.jsInt64 = paramOrDefault(params, "js_int64", "string")
+	o.jsNsSep = params["js_ns_sep"]
+	o.jsTypedefs = params["js_typedefs"] == "true"
+
+	o.genCache = params["gen_cache"] == "true"
+	o.cacheTtlMs = intParamOrDefault(params, "cache_ttl_ms", 1000)
+	o.genDedupe = params["gen_dedupe"] == "true"
+	o.genOptimistic = params["gen_optimistic"] == "true"
+	o.genTrace = params["gen_trace"] == "true"
+	o.genMetadata = params["gen_metadata"] == "true"
+	o.genRawOverload = params["gen_raw_overload"] == "true"
+	o.tsGenInterface = params["ts_gen_interface"] == "true"
+	o.genConnectionEvents = params["gen_connection_events"] == "true"
+	o.csGenSyncWrapper = params["cs_gen_sync_wrapper"] == "true"
+	o.csArgChecks = params["cs_arg_checks"] == "true"
+	o.genBatch = params["gen_batch"] == "true"
+	o.genReflection = params["gen_reflection"] == "true"
+	o.genStreaming = params["gen_streaming"] == "true"
+	o.genStreamManager = params["gen_stream_manager"] == "true"
+	o.csStreamStyle = paramOrDefault(params, "cs_stream_style", "async_enumerable")
+	o.streamFallback = params["stream_fallback"]
+	o.streamPollIntervalMs = intParamOrDefault(params, "stream_poll_interval_ms", 1000)
+	o.genBackpressure = params["gen_backpressure"] == "true"
+	o.genBaseUrl = params["gen_base_url"] == "true"
+	o.genExposeTransport = params["gen_expose_transport"] == "true"
+	o.genInterceptors = params["gen_interceptors"] == "true"
+	o.genOtel = params["gen_otel"] == "true"
+	o.genLogHook = params["gen_log_hook"] == "true"
+	o.genOfflineQueue = params["gen_offline_queue"] == "true"
+	o.wsReconnect = params["ws_reconnect"] == "true"
+	o.wsReconnectMax = intParamOrDefault(params, "ws_reconnect_max", 5)
+	o.wsReconnectBackoffMs = intParamOrDefault(params, "ws_reconnect_backoff_ms", 500)
+	o.defaultTimeoutMs = intParamOrDefault(params, "default_timeout_ms", 0)
+	o.maxPayloadBytes = intParamOrDefault(params, "max_payload_bytes", 0)
+	o.maxConcurrent = intParamOrDefault(params, "max_concurrent", 0)
+	o.csMethodPrologue = parseMethodSnippet("cs_method_prologue", params["cs_method_prologue"])
+	o.csMethodEpilogue = parseMethodSnippet("cs_method_epilogue", params["cs_method_epilogue"])
+
+	o.genEnvelope = params["gen_envelope"] == "true"
+	o.genCancel = params["gen_cancel"] == "true"
+	o.genSign = params["gen_sign"] == "true"
+	o.genSerializer = params["gen_serializer"] == "true"
+	o.schemaVersion = params["schema_version"]
+	o.schemaMismatch = paramOrDefault(params, "schema_mismatch", "warn")
+	o.compression = paramOrDefault(params, "compression", "none")
+
+	o.genReport = params["gen_report"] == "true"
+	o.singleFile = params["single_file"] == "true"
+	o.genClientFactory = params["gen_client_factory"] == "true"
+	o.genFacade = params["gen_facade"] == "true"
+	o.csGenDiExtensions = params["cs_gen_di_extensions"] == "true"
+	o.csDiLifetime = paramOrDefault(params, "cs_di_lifetime", "singleton")
+	o.genMarkdown = params["gen_markdown"] == "true"
+	o.genFieldNumbers = params["gen_field_numbers"] == "true"
+	o.genEnumNames = params["gen_enum_names"] == "true"
+	o.genMessageRegistry = params["gen_message_registry"] == "true"
+	o.genTests = params["gen_tests"] == "true"
+	o.genJSONSchema = params["gen_json_schema"] == "true"
+	o.genExamples = params["gen_examples"] == "true"
+	o.jsonEnum = paramOrDefault(params, "json_enum", "name")
+
+	o.filenamePattern = params["filename_pattern"]
+	o.maxFilenameLen = intParamOrDefault(params, "max_filename_len", 255)
+	o.csFormatCmd = strings.Fields(params["cs_format_cmd"])
+	o.eol = paramOrDefault(params, "eol", "lf")
+	return o
+}
+
+// validate fails the run on invalid values and on options that cannot be
+// combined, and warns about options that have no effect.
+func (o *generatorOptions) validate() {
+	if o.csNamespace != "" && !csNamespaceRe.MatchString(o.csNamespace) {
+		fail("invalid cs_namespace %q: expected a namespace such as Acme.Api", o.csNamespace)
+	}
+	if o.csNamespaceSuffix != "" && !csNamespaceRe.MatchString(o.csNamespaceSuffix) {
+		fail("invalid cs_namespace_suffix %q: expected namespace segments such as Rpc", o.csNamespaceSuffix)
+	}
+	if !csNamespaceRe.MatchString(o.csProtobufNs) {
+		fail("invalid cs_protobuf_ns %q: expected a namespace such as Google.Protobuf", o.csProtobufNs)
+	}
+	if o.jsInt64 != "number" && o.jsInt64 != "string" && o.jsInt64 != "bigint" {
+		fail("invalid js_int64 %q: expected number, string or bigint", o.jsInt64)
+	}
+	if !jsIdentRe.MatchString(o.jsNsSep) {
+		fail("invalid js_ns_sep %q: must only contain identifier characters", o.jsNsSep)
+	}
+	if o.csDiLifetime != "singleton" && o.csDiLifetime != "scoped" && o.csDiLifetime != "transient" {
+		fail("invalid cs_di_lifetime %q: expected singleton, scoped or transient", o.csDiLifetime)
+	}
+	if o.genCancel && !o.genEnvelope {
+		fail("gen_cancel requires gen_envelope: cancellation frames name the call by the request id of its envelope")
+	}
+	if o.genSign && !o.genEnvelope {
+		fail("gen_sign requires gen_envelope: the signature travels in the envelope of the call")
+	}
+	if o.schemaVersion != "" && !schemaVersionRe.MatchString(o.schemaVersion) {
+		fail("invalid schema_version %q: expected letters, digits, '.', '_' and '-'", o.schemaVersion)
+	}
+	if o.schemaVersion != "" && !o.genEnvelope {
+		fail("schema_version requires gen_envelope: the version travels in the envelope of the call")
+	}
+	if o.schemaMismatch != "warn" && o.schemaMismatch != "error" {
+		fail("invalid schema_mismatch %q: expected warn or error", o.schemaMismatch)
+	}
+	if o.params["schema_mismatch"] != "" && o.schemaVersion == "" {
+		warn("schema_mismatch is unused without schema_version")
+	}
+	if o.compression != "none" && o.compression != "gzip" {
+		fail("invalid compression %q: expected none or gzip", o.compression)
+	}
+	if o.compression == "gzip" && !o.genEnvelope {
+		fail("compression=gzip requires gen_envelope: the compression flag travels in the envelope of the call")
+	}
+	if o.csMethodCase != "pascal" && o.csMethodCase != "preserve" {
+		fail("invalid cs_method_case %q: expected pascal or preserve", o.csMethodCase)
+	}
+	if o.methodNameCollision != "error" && o.methodNameCollision != "rename" {
+		fail("invalid method_name_collision %q: expected error or rename", o.methodNameCollision)
+	}
+	if o.streamFallback != "" && o.streamFallback != "poll" {
+		fail("invalid stream_fallback %q: expected poll", o.streamFallback)
+	}
+	if o.wsReconnectMax == 0 {
+		fail("invalid ws_reconnect_max 0: expected at least one attempt")
+	}
+	if (o.params["ws_reconnect_max"] != "" || o.params["ws_reconnect_backoff_ms"] != "") && !o.wsReconnect {
+		warn("ws_reconnect_max and ws_reconnect_backoff_ms are unused without ws_reconnect")
+	}
+	if !o.genStreaming {
+		for _, key := range []string{"cs_stream_style", "stream_fallback", "stream_poll_interval_ms", "gen_stream_manager", "gen_backpressure"} {
+			if o.params[key] != "" {
+				warn("%s is unused without gen_streaming", key)
+			}
+		}
+	}
+	if o.genEnvelope && o.genCSClient && (o.genTrace || o.genMetadata) {
+		fail("gen_envelope cannot be combined with gen_trace or gen_metadata for C# clients: their envelopes are sent with cs_raw_transport_method, which carries neither")
+	}
+	if o.genSerializer && o.genCSClient && (o.genTrace || o.genMetadata) {
+		fail("gen_serializer cannot be combined with gen_trace or gen_metadata for C# clients: serialized requests are sent with cs_raw_transport_method, which carries neither")
+	}
+	if o.csAccess != "public" && o.csAccess != "internal" {
+		fail("invalid cs_access %q: expected public or internal", o.csAccess)
+	}
+	if o.csStreamStyle != "async_enumerable" && o.csStreamStyle != "callback" {
+		fail("invalid cs_stream_style %q: expected async_enumerable or callback", o.csStreamStyle)
+	}
+	if o.jsonEnum != "name" && o.jsonEnum != "number" {
+		fail("invalid json_enum %q: expected name or number", o.jsonEnum)
+	}
+	if o.params["json_enum"] != "" && !o.genJSONSchema && !o.genExamples {
+		warn("json_enum is unused without gen_json_schema or gen_examples")
+	}
+	if o.csFileScopedNamespace && o.csNoNamespace {
+		fail("cs_file_scoped_namespace cannot be combined with cs_no_namespace: there is no namespace to declare")
+	}
+	if o.filenamePattern != "" {
+		if !strings.Contains(o.filenamePattern, "{service}") {
+			fail("invalid filename_pattern %q: must contain {service}", o.filenamePattern)
+		}
+		for _, ph := range filenamePlaceholderRe.FindAllString(o.filenamePattern, -1) {
+			if !contains([]string{"{proto}", "{service}", "{lang}", "{role}", "{ext}"}, ph) {
+				fail("invalid filename_pattern %q: unknown placeholder %s", o.filenamePattern, ph)
+			}
+		}
+	}
+	if o.eol != "lf" && o.eol != "crlf" {
+		fail("invalid eol %q: expected lf or crlf", o.eol)
+	}
+}
+
+// setTargets sets each target to enabled(target), e.g. enabled("cs_client").
+func (o *generatorOptions) setTargets(enabled func(target string) bool) {
+	o.genCSClient = enabled("cs_client")
+	o.genCSServer = enabled("cs_server")
+	o.genJSClient = enabled("js_client")
+	o.genJSServer = enabled("js_server")
+	o.genTSClient = enabled("ts_client")
+	o.genTSServer = enabled("ts_server")
+	o.genPHPClient = enabled("php_client")
+}
+
+// forFile returns the options of one file to generate: a target is enabled
+// when a parameter sets it, which wins over the file, or else when the
+// (webviewrpc.targets) option of the file lists it. The other options are
+// those of the run.
+func (o *generatorOptions) forFile(name string) *generatorOptions {
+	f := *o
+	f.setTargets(func(target string) bool {
+		if v, set := o.params[target]; set {
+			return v == "true"
+		}
+		return o.fileTargets[name][target]
+	})
+	return &f
+}
+
+// targets lists the per-service outputs, enabled by the target parameters.
+func (o *generatorOptions) targets() []genTarget {
+	return []genTarget{
+		{o.genCSClient, csharpClientTmpl, "cs", "client", "%s_%sClient.cs"}, // (A) C# Client
+		{o.genCSServer, csharpServerTmpl, "cs", "server", "%s_%sBase.cs"},   // (B) C# Server
+		{o.genJSClient, jsClientTmpl, "js", "client", "%s_%sClient.js"},     // (C) JS Client
+		{o.genJSServer, jsServerTmpl, "js", "server", "%s_%sBase.js"},       // (D) JS Server
+		{o.genTSClient, tsClientTmpl, "ts", "client", "%s_%sClient.ts"},     // (E) TS Client
+		{o.genTSServer, tsServerTmpl, "ts", "server", "%s_%sBase.ts"},       // (F) TS Server
+		{o.genPHPClient, phpClientTmpl, "php", "client", "%s_%sClient.php"}, // (F2) PHP Client
+	}
+}
+
+// runtimeInfo is the run-wide part of the runtime file data; HasTimeouts,
+// StreamPoll and GenBackpressure depend on the methods and are set while the
+// services are collected.
+func (o *generatorOptions) runtimeInfo() runtimeInfo {
+	return runtimeInfo{
+		GenTrace:         o.genTrace,
+		GenMetadata:      o.genMetadata,
+		GenBatch:         o.genBatch,
+		GenEnvelope:      o.genEnvelope,
+		GenCancel:        o.genCancel,
+		GenSign:          o.genSign,
+		GenSchemaVersion: o.schemaVersion != "",
+		GzipCompression:  o.compression == "gzip",
+		GenInterceptors:  o.genInterceptors,
+		GenOtel:          o.genOtel,
+		GenLogHook:       o.genLogHook,
+		WsReconnect:      o.wsReconnect,
+		GenOfflineQueue:  o.genOfflineQueue,
+		GenSerializer:    o.genSerializer,
+		CsProtobufNs:     o.csProtobufNs,
+		CsAccess:         o.csAccess,
+	}
+}
+
+// serviceInfo is the template data of a service with the given methods, as
+// far as it follows from the options and the methods; the caller fills in
+// the names and types of the proto.
+func (o *generatorOptions) serviceInfo(methods []methodInfo) serviceInfo {
+	return serviceInfo{
+		Methods: methods,
+
+		HasServerStreaming: hasServerStreaming(methods),
+		HasAuthMethods:     hasAuthMethods(methods),
+		HasCachedMethods:   hasCachedMethods(methods),
+		HasDedupedMethods:  hasDedupedMethods(methods),
+		HasOptimistic:      hasOptimisticMethods(methods),
+		HasTimeouts:        hasTimeouts(methods),
+		CacheTtlMs:         o.cacheTtlMs,
+		MaxPayloadBytes:    o.maxPayloadBytes,
+		MaxConcurrent:      o.maxConcurrent,
+
+		TypeNames:     collectTypeNames(methods),
+		ClientImports: collectCodecImports(methods, "encode", "decode"),
+		ServerImports: collectCodecImports(methods, "decode", "encode"),
+
+		CsTransportMethod: o.csTransportMethod,
+		JsTransportMethod: o.jsTransportMethod,
+
+		CsProtobufNs: o.csProtobufNs,
+
+		GenRawOverload:       o.genRawOverload,
+		CsRawTransportMethod: o.csRawTransportMethod,
+
+		GenTrace:    o.genTrace,
+		GenMetadata: o.genMetadata,
+
+		TsGenInterface: o.tsGenInterface,
+		GenEnumNames:   o.genEnumNames,
+
+		GenConnectionEvents:  o.genConnectionEvents,
+		CsGenSyncWrapper:     o.csGenSyncWrapper,
+		CsArgChecks:          o.csArgChecks,
+		CsStreamCallback:     o.csStreamStyle == "callback",
+		GenBatch:             o.genBatch,
+		GenReflection:        o.genReflection,
+		GenEnvelope:          o.genEnvelope,
+		GenStreamManager:     o.genStreamManager,
+		GenCancel:            o.genCancel,
+		GenSign:              o.genSign,
+		SchemaVersion:        o.schemaVersion,
+		SchemaMismatch:       o.schemaMismatch,
+		GzipCompression:      o.compression == "gzip",
+		GenSerializer:        o.genSerializer,
+		StreamPoll:           o.streamFallback == "poll" && hasServerStreaming(methods),
+		StreamPollIntervalMs: o.streamPollIntervalMs,
+		GenBackpressure:      o.genBackpressure && hasServerStreaming(methods),
+		GenBaseUrl:           o.genBaseUrl,
+		GenExposeTransport:   o.genExposeTransport,
+		GenInterceptors:      o.genInterceptors,
+		GenOtel:              o.genOtel,
+		GenLogHook:           o.genLogHook,
+		GenOfflineQueue:      o.genOfflineQueue,
+		WsReconnect:          o.wsReconnect,
+		WsReconnectMax:       o.wsReconnectMax,
+		WsReconnectBackoffMs: o.wsReconnectBackoffMs,
+
+		CsAccess: o.csAccess,
+	}
+}
@@ -0,0 +1,53 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Server: GreeterServiceBase
+
+// 메서드별 인코딩 함수를 가져옴
+// Get encoding/decoding functions for each method
+import { decodeHelloRequest, encodeHelloReply } from './Greeter.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * 추상 클래스 (C#의 GreeterBase)
+ * 사용자(서버구현자)는 이 클래스를 상속해서 실제 로직을 override한다.
+ * Abstract class (like C#'s GreeterBase)
+ * Users (server implementors) should inherit this class and override the methods.
+ */
+export class GreeterBase {
+  
+  /**
+   * async SayHello
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    throw new Error("Method SayHello must be implemented");
+  }
+  
+}
+
+/**
+ * static BindService, (C#의 Greeter.BindService(impl))
+ * - impl: GreeterBase implementation
+ * - return: ServiceDefinition(methodHandlers)
+ */
+export class Greeter {
+  static bindService(impl) {
+    const def = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Greeter.SayHello"] = async (reqBytes) => {
+      const reqObj = decodeHelloRequest(reqBytes);
+      const respObj = await impl.SayHello(reqObj);
+      return encodeHelloReply(respObj);
+    };
+    
+
+    return def;
+  }
+}
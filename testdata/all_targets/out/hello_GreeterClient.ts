@@ -0,0 +1,60 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
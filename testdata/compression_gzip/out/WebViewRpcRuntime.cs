@@ -0,0 +1,258 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support types shared by the generated clients and servers
+using System;
+using System.IO;
+using System.IO.Compression;
+using System.Text;
+using Cysharp.Threading.Tasks;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Raised by RpcCallEnvelope.Open for a response envelope with the error status:
+    /// the server method failed with the message.
+    /// </summary>
+    public class RpcCallException : Exception
+    {
+        public string Service { get; }
+        public string Method { get; }
+        public string RequestId { get; }
+
+        public RpcCallException(string service, string method, string requestId, string message)
+            : base($"RPC call {service}.{method} failed: {message}")
+        {
+            Service = service;
+            Method = method;
+            RequestId = requestId;
+        }
+    }
+
+    /// <summary>
+    /// Wrapper of every request and response with gen_envelope, naming the call it belongs to.
+    /// Wire format, all integers uint32 little-endian: service, method and request id
+    /// (each length + UTF-8), a status byte, then the payload (length + message bytes,
+    /// or UTF-8 error message with StatusError).
+    /// </summary>
+    public sealed class RpcCallEnvelope
+    {
+        public const byte StatusOk = 0;
+        public const byte StatusError = 1;
+
+        public string Service { get; }
+        public string Method { get; }
+        public string RequestId { get; }
+        public byte Status { get; }
+        public byte[] Payload { get; }
+
+        public RpcCallEnvelope(string service, string method, string requestId, byte[] payload, byte status = StatusOk)
+        {
+            Service = service;
+            Method = method;
+            RequestId = requestId;
+            Payload = payload;
+            Status = status;
+        }
+
+        /// <summary>
+        /// Response envelope reporting that the server method failed with message.
+        /// </summary>
+        public static RpcCallEnvelope Error(string service, string method, string requestId, string message)
+        {
+            return new RpcCallEnvelope(service, method, requestId, Encoding.UTF8.GetBytes(message), StatusError);
+        }
+
+        /// <summary>
+        /// Creates the id pairing a request envelope with its response.
+        /// </summary>
+        public static string NewRequestId()
+        {
+            return Guid.NewGuid().ToString();
+        }
+
+        public byte[] Encode()
+        {
+            var output = new MemoryStream();
+            WriteBytes(output, Encoding.UTF8.GetBytes(Service));
+            WriteBytes(output, Encoding.UTF8.GetBytes(Method));
+            WriteBytes(output, Encoding.UTF8.GetBytes(RequestId));
+            output.WriteByte(Status);
+            WriteBytes(output, Payload);
+            return output.ToArray();
+        }
+
+        /// <summary>
+        /// Decodes an envelope, throwing when it was sent for another method.
+        /// </summary>
+        public static RpcCallEnvelope Decode(byte[] bytes, string service, string method)
+        {
+            var pos = 0;
+            var envelopeService = Encoding.UTF8.GetString(ReadBytes(bytes, ref pos));
+            var envelopeMethod = Encoding.UTF8.GetString(ReadBytes(bytes, ref pos));
+            var requestId = Encoding.UTF8.GetString(ReadBytes(bytes, ref pos));
+            if (pos >= bytes.Length)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            var status = bytes[pos++];
+            var envelope = new RpcCallEnvelope(envelopeService, envelopeMethod, requestId, ReadBytes(bytes, ref pos), status);
+            if (envelope.Service != service || envelope.Method != method)
+            {
+                throw new InvalidOperationException($"Envelope of {envelope.Service}.{envelope.Method} received by {service}.{method}");
+            }
+            return envelope;
+        }
+
+        /// <summary>
+        /// Payload of a response envelope, throwing when it answers another request and
+        /// RpcCallException when it carries StatusError.
+        /// </summary>
+        public static byte[] Open(byte[] bytes, string service, string method, string requestId)
+        {
+            var envelope = Decode(bytes, service, method);
+            if (envelope.RequestId != requestId)
+            {
+                throw new InvalidOperationException($"Response to request {envelope.RequestId} received for request {requestId}");
+            }
+            if (envelope.Status == StatusError)
+            {
+                throw new RpcCallException(service, method, requestId, Encoding.UTF8.GetString(envelope.Payload));
+            }
+            if (envelope.Status != StatusOk)
+            {
+                throw new FormatException($"Unknown envelope status {envelope.Status}");
+            }
+            return envelope.Payload;
+        }
+
+        private static byte[] ReadBytes(byte[] bytes, ref int pos)
+        {
+            if (pos + 4 > bytes.Length)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            var length = (uint)(bytes[pos] | bytes[pos + 1] << 8 | bytes[pos + 2] << 16 | bytes[pos + 3] << 24);
+            pos += 4;
+            if (length > bytes.Length - pos)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            var value = new byte[length];
+            Array.Copy(bytes, pos, value, 0, (int)length);
+            pos += (int)length;
+            return value;
+        }
+
+        private static void WriteBytes(MemoryStream output, byte[] value)
+        {
+            var length = (uint)value.Length;
+            output.WriteByte((byte)length);
+            output.WriteByte((byte)(length >> 8));
+            output.WriteByte((byte)(length >> 16));
+            output.WriteByte((byte)(length >> 24));
+            output.Write(value, 0, value.Length);
+        }
+    }
+
+    /// <summary>
+    /// Gzip compression of encoded envelopes (compression=gzip): the payload is
+    /// compressed and the Gzip bit of the status byte set.
+    /// </summary>
+    public static class RpcCompression
+    {
+        public const byte Gzip = 0x80;
+
+        /// <summary>
+        /// Gzip-compresses the payload of an encoded envelope and sets Gzip in its status byte.
+        /// </summary>
+        public static byte[] Compress(byte[] envelope)
+        {
+            var pos = StatusOffset(envelope);
+            var output = new MemoryStream();
+            using (var gzip = new GZipStream(output, CompressionMode.Compress, true))
+            {
+                gzip.Write(envelope, pos + 5, PayloadLength(envelope, pos));
+            }
+            return ReplacePayload(envelope, pos, (byte)(envelope[pos] | Gzip), output.ToArray());
+        }
+
+        /// <summary>
+        /// Decompresses the payload of an encoded envelope flagged with Gzip and
+        /// clears the flag; other envelopes are returned as they are.
+        /// </summary>
+        public static byte[] Decompress(byte[] envelope)
+        {
+            var pos = StatusOffset(envelope);
+            if ((envelope[pos] & Gzip) == 0)
+            {
+                return envelope;
+            }
+            var output = new MemoryStream();
+            using (var gzip = new GZipStream(new MemoryStream(envelope, pos + 5, PayloadLength(envelope, pos)), CompressionMode.Decompress))
+            {
+                gzip.CopyTo(output);
+            }
+            return ReplacePayload(envelope, pos, (byte)(envelope[pos] & ~Gzip), output.ToArray());
+        }
+
+        /// <summary>
+        /// Response envelope compressed when the request envelope (as received) was,
+        /// so that clients only get compressed responses to compressed requests.
+        /// </summary>
+        public static byte[] CompressLike(byte[] envelope, byte[] request)
+        {
+            return (request[StatusOffset(request)] & Gzip) != 0 ? Compress(envelope) : envelope;
+        }
+
+        // offset of the status byte, after service, method and request id
+        private static int StatusOffset(byte[] envelope)
+        {
+            var pos = 0;
+            for (var i = 0; i < 3; i++)
+            {
+                pos += 4 + ReadLength(envelope, pos);
+            }
+            if (pos >= envelope.Length)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            ReadLength(envelope, pos + 1);
+            return pos;
+        }
+
+        private static int PayloadLength(byte[] envelope, int statusPos)
+        {
+            return ReadLength(envelope, statusPos + 1);
+        }
+
+        // length prefix at pos, checked against the bytes that follow it
+        private static int ReadLength(byte[] envelope, int pos)
+        {
+            if (pos + 4 > envelope.Length)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            var length = (uint)(envelope[pos] | envelope[pos + 1] << 8 | envelope[pos + 2] << 16 | envelope[pos + 3] << 24);
+            if (length > envelope.Length - pos - 4)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            return (int)length;
+        }
+
+        private static byte[] ReplacePayload(byte[] envelope, int statusPos, byte status, byte[] payload)
+        {
+            var end = statusPos + 5 + PayloadLength(envelope, statusPos);
+            var length = (uint)payload.Length;
+            var output = new MemoryStream();
+            output.Write(envelope, 0, statusPos);
+            output.WriteByte(status);
+            output.WriteByte((byte)length);
+            output.WriteByte((byte)(length >> 8));
+            output.WriteByte((byte)(length >> 16));
+            output.WriteByte((byte)(length >> 24));
+            output.Write(payload, 0, payload.Length);
+            output.Write(envelope, end, envelope.Length - end);
+            return output.ToArray();
+        }
+    }
+}
@@ -0,0 +1,45 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            var requestId = RpcCallEnvelope.NewRequestId();
+            var call = _rpcClient.CallMethodRaw("Greeter.SayHello", RpcCompression.Compress(new RpcCallEnvelope("Greeter", "SayHello", requestId, request.ToByteArray()).Encode()));
+            var response = HelloReply.Parser.ParseFrom(RpcCallEnvelope.Open(RpcCompression.Decompress(await call), "Greeter", "SayHello", requestId));
+            return response;
+        }
+        
+    }
+}
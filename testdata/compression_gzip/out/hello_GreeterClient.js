@@ -0,0 +1,44 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { newRequestId, encodeEnvelope, openEnvelope, compressEnvelope, decompressEnvelope } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const requestId = newRequestId();
+    // compression=gzip: the request payload travels gzip-compressed, flagged in the envelope
+    const reqEnvelope = await compressEnvelope(encodeEnvelope("Greeter", "SayHello", requestId, reqBytes));
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqEnvelope);
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(openEnvelope(await decompressEnvelope(respBytes), "Greeter", "SayHello", requestId));
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,64 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+import { newRequestId, encodeEnvelope, openEnvelope, compressEnvelope, decompressEnvelope } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    const requestId = newRequestId();
+    // compression=gzip: the request payload travels gzip-compressed, flagged in the envelope
+    const reqEnvelope = await compressEnvelope(encodeEnvelope("Greeter", "SayHello", requestId, reqBytes));
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqEnvelope);
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(openEnvelope(await decompressEnvelope(respBytes), "Greeter", "SayHello", requestId));
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,22 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Microsoft.Extensions.DependencyInjection;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Registers every generated client of this run with Microsoft.Extensions.DependencyInjection.
+    /// </summary>
+    public static class WebviewRpcServiceCollectionExtensions
+    {
+        /// <summary>
+        /// Adds each client as the service of its interface with ServiceLifetime.Singleton,
+        /// created over the WebViewRpcClient registered in the container.
+        /// </summary>
+        public static IServiceCollection AddWebviewRpcClients(this IServiceCollection services)
+        {
+            services.AddSingleton<global::Shop.ICartClient>(sp => new global::Shop.CartClient(sp.GetRequiredService<WebViewRpcClient>()));
+            services.AddSingleton<global::Shop.IOrdersClient>(sp => new global::Shop.OrdersClient(sp.GetRequiredService<WebViewRpcClient>()));
+            return services;
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Shop
+{
+    public interface ICartClient
+    {
+        
+        UniTask<Item> Add(Item request);
+        
+    }
+
+    public class CartClient : ICartClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "shop.Cart";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public CartClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends an Item and returns an Item.
+        /// </summary>
+        public async UniTask<Item> Add(Item request)
+        {
+            var response = await _rpcClient.CallMethod<Item>("Cart.Add", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,45 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Helloworld;
+
+/// <summary>
+/// Override your own implementation of this class
+/// </summary>
+public abstract class GreeterBase
+{
+    
+    public abstract UniTask<HelloReply> SayHello(HelloRequest request);
+    
+}
+
+/// <summary>
+/// Provides "BindService" method to bind your implementation to the generated service definition.
+/// Works similar to gRPC's ServerServiceDefinition.BindService.
+/// </summary>
+public static class Greeter
+{
+    /// <summary>
+    /// Fully-qualified proto name of the service, for routing and logging.
+    /// </summary>
+    public const string ServiceName = "helloworld.Greeter";
+
+    public static ServiceDefinition BindService(GreeterBase impl)
+    {
+        var def = new ServiceDefinition();
+
+        
+        def.MethodHandlers["Greeter.SayHello"] = async (reqBytes) =>
+        {
+            var req = new HelloRequest();
+            req.MergeFrom(reqBytes);
+            var resp = await impl.SayHello(req);
+            return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+        };
+        
+
+        return def;
+    }
+}
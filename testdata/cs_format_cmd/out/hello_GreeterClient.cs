@@ -0,0 +1,43 @@
+// AUTO-GENERATED BY PROTOC-GEN-WEBVIEWRPC
+USING CYSHARP.THREADING.TASKS;
+USING GOOGLE.PROTOBUF;
+USING WEBVIEWRPC;
+
+NAMESPACE HELLOWORLD
+{
+    PUBLIC INTERFACE IGREETERCLIENT
+    {
+        
+        UNITASK<HELLOREPLY> SAYHELLO(HELLOREQUEST REQUEST);
+        
+    }
+
+    /// <SUMMARY>
+    /// THE GREETER SERVICE.
+    /// </SUMMARY>
+    PUBLIC CLASS GREETERCLIENT : IGREETERCLIENT
+    {
+        /// <SUMMARY>
+        /// FULLY-QUALIFIED PROTO NAME OF THE SERVICE, FOR ROUTING AND LOGGING.
+        /// </SUMMARY>
+        PUBLIC CONST STRING SERVICENAME = "HELLOWORLD.GREETER";
+
+        PRIVATE READONLY WEBVIEWRPCCLIENT _RPCCLIENT;
+
+        PUBLIC GREETERCLIENT(WEBVIEWRPCCLIENT RPCCLIENT)
+        {
+            THIS._RPCCLIENT = RPCCLIENT;
+        }
+
+        
+        /// <SUMMARY>
+        /// SENDS A GREETING.
+        /// </SUMMARY>
+        PUBLIC ASYNC UNITASK<HELLOREPLY> SAYHELLO(HELLOREQUEST REQUEST)
+        {
+            VAR RESPONSE = AWAIT _RPCCLIENT.CALLMETHOD<HELLOREPLY>("GREETER.SAYHELLO", REQUEST);
+            RETURN RESPONSE;
+        }
+        
+    }
+}
@@ -0,0 +1,66 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Users
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class UsersBase
+    {
+        
+        public abstract UniTask<Req> GetUser(Req request);
+        
+        public abstract UniTask<Req> GetHTTPStatus(Req request);
+        
+        public abstract UniTask<Req> ListUsers(Req request);
+        
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Users
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "users.Users";
+
+        public static ServiceDefinition BindService(UsersBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Users.get_user"] = async (reqBytes) =>
+            {
+                var req = new Req();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.GetUser(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+            def.MethodHandlers["Users.GetHTTPStatus"] = async (reqBytes) =>
+            {
+                var req = new Req();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.GetHTTPStatus(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+            def.MethodHandlers["Users.list_users"] = async (reqBytes) =>
+            {
+                var req = new Req();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.ListUsers(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+
+            return def;
+        }
+    }
+}
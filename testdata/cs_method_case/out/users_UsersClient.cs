@@ -0,0 +1,69 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System.Collections.Generic;
+using System.Runtime.CompilerServices;
+using System.Threading;
+
+namespace Users
+{
+    public interface IUsersClient
+    {
+        
+        UniTask<Req> GetUser(Req request);
+        
+        UniTask<Req> GetHTTPStatus(Req request);
+        
+        IAsyncEnumerable<Req> ListUsersAsync(Req request, CancellationToken cancellationToken = default);
+        
+    }
+
+    public class UsersClient : IUsersClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "users.Users";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public UsersClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Req and returns a Req.
+        /// </summary>
+        public async UniTask<Req> GetUser(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Req>("Users.get_user", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a Req and returns a Req.
+        /// </summary>
+        public async UniTask<Req> GetHTTPStatus(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Req>("Users.GetHTTPStatus", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a Req and returns a Req.
+        /// Server-streaming call, yields each response frame as it arrives.
+        /// Cancelling the token stops the stream.
+        /// </summary>
+        public async IAsyncEnumerable<Req> ListUsersAsync(Req request, [EnumeratorCancellation] CancellationToken cancellationToken = default)
+        {
+            await foreach (var response in _rpcClient.CallServerStreamingMethod<Req>("Users.list_users", request, cancellationToken).WithCancellation(cancellationToken))
+            {
+                yield return response;
+            }
+        }
+        
+    }
+}
@@ -0,0 +1,77 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+using System.Collections.Generic;
+using System.Threading;
+
+namespace Live
+{
+    public interface IFeedClient
+    {
+        
+        UniTask<Update> Get(Topic request);
+        
+        void Subscribe(Topic request, Action<Update> onMessage, Action onComplete, Action<Exception> onError, CancellationToken cancellationToken = default);
+        
+    }
+
+    public class FeedClient : IFeedClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "live.Feed";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public FeedClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Topic and returns an Update.
+        /// </summary>
+        public async UniTask<Update> Get(Topic request)
+        {
+            var response = await _rpcClient.CallMethod<Update>("Feed.Get", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a Topic and returns an Update.
+        /// Server-streaming call, invokes onMessage for each response frame as it arrives,
+        /// then onComplete when the stream ends or onError when it fails.
+        /// Cancelling the token stops the stream without invoking either.
+        /// </summary>
+        public void Subscribe(Topic request, Action<Update> onMessage, Action onComplete, Action<Exception> onError, CancellationToken cancellationToken = default)
+        {
+            ConsumeSubscribe(request, onMessage, onComplete, onError, cancellationToken).Forget();
+        }
+
+        private async UniTaskVoid ConsumeSubscribe(Topic request, Action<Update> onMessage, Action onComplete, Action<Exception> onError, CancellationToken cancellationToken)
+        {
+            try
+            {
+                await foreach (var response in _rpcClient.CallServerStreamingMethod<Update>("Feed.Subscribe", request, cancellationToken).WithCancellation(cancellationToken))
+                {
+                    onMessage(response);
+                }
+            }
+            catch (OperationCanceledException) when (cancellationToken.IsCancellationRequested)
+            {
+                return;
+            }
+            catch (Exception e)
+            {
+                onError(e);
+                return;
+            }
+            onComplete();
+        }
+        
+    }
+}
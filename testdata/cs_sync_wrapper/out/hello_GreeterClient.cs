@@ -0,0 +1,54 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        HelloReply SayHelloSync(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            var response = await _rpcClient.CallMethod<HelloReply>("Greeter.SayHello", request);
+            return response;
+        }
+
+        /// <summary>
+        /// Blocking wrapper of <c>SayHello</c> for call sites that cannot await.
+        /// WARNING: blocks the calling thread until the response arrives, which deadlocks when
+        /// called from the thread (e.g. Unity's main thread) that has to deliver that response.
+        /// </summary>
+        public HelloReply SayHelloSync(HelloRequest request)
+        {
+            return SayHello(request).GetAwaiter().GetResult();
+        }
+        
+    }
+}
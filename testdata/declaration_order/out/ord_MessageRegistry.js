@@ -0,0 +1,22 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Message factories of ord.proto by full proto name
+
+/**
+ * Functions creating a message object of ord.proto with its default field values, by full proto name
+ */
+export const OrdMessageFactories = Object.freeze({
+  "ord.Zeta": () => ({}),
+  "ord.Zeta.Inner": () => ({}),
+  "ord.Alpha": () => ({}),
+  "ord.Mid": () => ({}),
+});
+
+/**
+ * A new message object of the type named fullName, undefined when the proto declares no such message
+ * @param {string} fullName e.g. "ord.Zeta"
+ * @returns {Object | undefined}
+ */
+export function createOrdMessage(fullName) {
+  const factory = Object.prototype.hasOwnProperty.call(OrdMessageFactories, fullName) ? OrdMessageFactories[fullName] : undefined;
+  return factory ? factory() : undefined;
+}
@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: SClient
+
+// Import encoding/decoding functions for each method
+import { encodeAlpha, decodeZeta } from './S.js';
+
+/**
+ * Fully-qualified proto name of S, for routing and logging
+ */
+export const SServiceName = "ord.S";
+
+export class SClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async M
+   * Sends an Alpha and returns a Zeta.
+   * @param { Alpha } requestObj
+   * @returns {Promise< Zeta >}
+   */
+  async M(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeAlpha(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("S.M", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeZeta(respBytes);
+    return respObj;
+  }
+  
+}
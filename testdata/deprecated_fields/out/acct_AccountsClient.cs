@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Acct
+{
+    public interface IAccountsClient
+    {
+        
+        UniTask<Account> Get(Account request);
+        
+    }
+
+    public class AccountsClient : IAccountsClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "acct.Accounts";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public AccountsClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends an Account and returns an Account.
+        /// </summary>
+        public async UniTask<Account> Get(Account request)
+        {
+            var response = await _rpcClient.CallMethod<Account>("Accounts.Get", request);
+            return response;
+        }
+        
+    }
+}
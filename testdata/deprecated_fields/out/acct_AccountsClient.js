@@ -0,0 +1,43 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: AccountsClient
+
+// Import encoding/decoding functions for each method
+import { encodeAccount, decodeAccount } from './Accounts.js';
+
+/**
+ * Fully-qualified proto name of Accounts, for routing and logging
+ */
+export const AccountsServiceName = "acct.Accounts";
+
+/**
+ * @typedef {Object} Account
+ * @property {string} [id]
+ * @property {string} [login] deprecated
+ */
+
+export class AccountsClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Get
+   * Sends an Account and returns an Account.
+   * @param { Account } requestObj
+   * @returns {Promise< Account >}
+   */
+  async Get(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeAccount(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Accounts.Get", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeAccount(respBytes);
+    return respObj;
+  }
+  
+}
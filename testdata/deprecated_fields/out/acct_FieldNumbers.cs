@@ -0,0 +1,12 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Field numbers of the messages of acct.proto
+
+namespace Acct
+{
+    public static class AccountFieldNumbers
+    {
+        public const int Id = 1;
+        [System.Obsolete("Account.login is deprecated")]
+        public const int Login = 2;
+    }
+}
@@ -0,0 +1,8 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Field numbers of the messages of acct.proto
+
+export const AccountFieldNumbers = Object.freeze({
+  id: 1,
+  /** @deprecated */
+  login: 2,
+});
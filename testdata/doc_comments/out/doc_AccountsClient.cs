@@ -0,0 +1,68 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Doc
+{
+    public interface IAccountsClient
+    {
+        
+        UniTask<Resp> Get(Req request);
+        
+        UniTask<Resp> Plain(Req request);
+        
+        UniTask<Resp> Watch(Req request);
+        
+    }
+
+    /// <summary>
+    /// Manages user accounts.
+    ///
+    /// All calls require a session.
+    /// </summary>
+    public class AccountsClient : IAccountsClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "doc.Accounts";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public AccountsClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Looks up an account by id | name.
+        /// </summary>
+        public async UniTask<Resp> Get(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Resp>("Accounts.Get", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a Req and returns a Resp.
+        /// </summary>
+        public async UniTask<Resp> Plain(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Resp>("Accounts.Plain", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Streams account changes
+        /// as they happen.
+        /// </summary>
+        public async UniTask<Resp> Watch(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Resp>("Accounts.Watch", request);
+            return response;
+        }
+        
+    }
+}
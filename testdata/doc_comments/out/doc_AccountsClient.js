@@ -0,0 +1,75 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: AccountsClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeResp } from './Accounts.js';
+
+/**
+ * Fully-qualified proto name of Accounts, for routing and logging
+ */
+export const AccountsServiceName = "doc.Accounts";
+
+/**
+ * Manages user accounts.
+ *
+ * All calls require a session.
+ */
+export class AccountsClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Get
+   * Looks up an account by id | name.
+   * @param { Req } requestObj
+   * @returns {Promise< Resp >}
+   */
+  async Get(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeReq(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Accounts.Get", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * async Plain
+   * Sends a Req and returns a Resp.
+   * @param { Req } requestObj
+   * @returns {Promise< Resp >}
+   */
+  async Plain(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeReq(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Accounts.Plain", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * async Watch
+   * Streams account changes
+   * as they happen.
+   * @param { Req } requestObj
+   * @returns {Promise< Resp >}
+   */
+  async Watch(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeReq(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Accounts.Watch", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+}
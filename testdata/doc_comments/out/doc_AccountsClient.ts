@@ -0,0 +1,99 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: AccountsClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeResp } from './Accounts';
+
+// Type definitions for request/response messages
+
+export interface Req {
+  [key: string]: any;
+}
+
+export interface Resp {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Accounts, for routing and logging
+ */
+export const AccountsServiceName = "doc.Accounts";
+
+/**
+ * Accounts RPC Client
+ * Provides type-safe methods to call Accounts on the server
+ * Manages user accounts.
+ *
+ * All calls require a session.
+ */
+export class AccountsClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Get method
+   * Looks up an account by id | name.
+   * @param requestObj - Req object
+   * @returns Promise resolving to Resp
+   */
+  async Get(requestObj: Req): Promise<Resp> {
+    // Encode request object to bytes
+    const reqBytes = encodeReq(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Accounts.Get", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * Call Plain method
+   * Sends a Req and returns a Resp.
+   * @param requestObj - Req object
+   * @returns Promise resolving to Resp
+   */
+  async Plain(requestObj: Req): Promise<Resp> {
+    // Encode request object to bytes
+    const reqBytes = encodeReq(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Accounts.Plain", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * Call Watch method
+   * Streams account changes
+   * as they happen.
+   * @param requestObj - Req object
+   * @returns Promise resolving to Resp
+   */
+  async Watch(requestObj: Req): Promise<Resp> {
+    // Encode request object to bytes
+    const reqBytes = encodeReq(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Accounts.Watch", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+}
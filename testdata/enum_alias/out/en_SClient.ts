@@ -0,0 +1,81 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: SClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeReq } from './S';
+
+// Type definitions for request/response messages
+
+export interface Req {
+  [key: string]: any;
+  pick?: ReqPick;
+}
+
+// Enums
+
+// const object instead of an enum: allow_alias gives several names the same value
+export const Status = {
+  UNKNOWN: 0,
+  STARTED: 1,
+  RUNNING: 1,
+} as const;
+export type Status = (typeof Status)[keyof typeof Status];
+
+export enum Color {
+  RED = 0,
+  GREEN = 1,
+}
+
+// Discriminated unions for oneof fields
+
+/**
+ * oneof pick of Req, discriminated by $case
+ */
+export type ReqPick =
+  | { $case: "color"; color: Color }
+  | { $case: "name"; name: string }
+  | { $case: undefined };
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of S, for routing and logging
+ */
+export const SServiceName = "en.S";
+
+/**
+ * S RPC Client
+ * Provides type-safe methods to call S on the server
+ */
+export class SClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Do method
+   * Sends a Req and returns a Req.
+   * @param requestObj - Req object
+   * @returns Promise resolving to Req
+   */
+  async Do(requestObj: Req): Promise<Req> {
+    // Encode request object to bytes
+    const reqBytes = encodeReq(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("S.Do", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeReq(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,10 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Exhaustiveness guard for switch statements over oneof $case values
+ * e.g. default: return assertNever(value);
+ */
+export function assertNever(value: never): never {
+  throw new Error("Unexpected oneof case: " + JSON.stringify(value));
+}
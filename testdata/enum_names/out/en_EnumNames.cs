@@ -0,0 +1,34 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Proto names of the enum values of en.proto
+
+namespace En
+{
+    public static class EnEnumExtensions
+    {
+        /// <summary>
+        /// Proto name of a Status value, the number itself for values not declared in the proto
+        /// </summary>
+        public static string ToDisplayString(this Status value)
+        {
+            switch ((int)value)
+            {
+                case 0: return "UNKNOWN";
+                case 1: return "STARTED";
+                default: return ((int)value).ToString();
+            }
+        }
+
+        /// <summary>
+        /// Proto name of a Color value, the number itself for values not declared in the proto
+        /// </summary>
+        public static string ToDisplayString(this Color value)
+        {
+            switch ((int)value)
+            {
+                case 0: return "RED";
+                case 1: return "GREEN";
+                default: return ((int)value).ToString();
+            }
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace En
+{
+    public interface ISClient
+    {
+        
+        UniTask<Req> Do(Req request);
+        
+    }
+
+    public class SClient : ISClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "en.S";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public SClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Req and returns a Req.
+        /// </summary>
+        public async UniTask<Req> Do(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Req>("S.Do", request);
+            return response;
+        }
+        
+    }
+}
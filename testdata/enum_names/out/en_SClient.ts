@@ -0,0 +1,103 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: SClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeReq } from './S';
+
+// Type definitions for request/response messages
+
+export interface Req {
+  [key: string]: any;
+  pick?: ReqPick;
+}
+
+// Enums
+
+// const object instead of an enum: allow_alias gives several names the same value
+export const Status = {
+  UNKNOWN: 0,
+  STARTED: 1,
+  RUNNING: 1,
+} as const;
+export type Status = (typeof Status)[keyof typeof Status];
+
+/**
+ * Proto name of a Status value, the number itself for values not declared in the proto
+ */
+export function StatusName(value: number): string {
+  switch (value) {
+    case 0: return "UNKNOWN";
+    case 1: return "STARTED";
+    default: return String(value);
+  }
+}
+
+export enum Color {
+  RED = 0,
+  GREEN = 1,
+}
+
+/**
+ * Proto name of a Color value, the number itself for values not declared in the proto
+ */
+export function ColorName(value: number): string {
+  switch (value) {
+    case 0: return "RED";
+    case 1: return "GREEN";
+    default: return String(value);
+  }
+}
+
+// Discriminated unions for oneof fields
+
+/**
+ * oneof pick of Req, discriminated by $case
+ */
+export type ReqPick =
+  | { $case: "color"; color: Color }
+  | { $case: "name"; name: string }
+  | { $case: undefined };
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of S, for routing and logging
+ */
+export const SServiceName = "en.S";
+
+/**
+ * S RPC Client
+ * Provides type-safe methods to call S on the server
+ */
+export class SClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Do method
+   * Sends a Req and returns a Req.
+   * @param requestObj - Req object
+   * @returns Promise resolving to Req
+   */
+  async Do(requestObj: Req): Promise<Req> {
+    // Encode request object to bytes
+    const reqBytes = encodeReq(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("S.Do", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeReq(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,60 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Server: GreeterServiceBase
+
+// 메서드별 인코딩 함수를 가져옴
+// Get encoding/decoding functions for each method
+import { decodeHelloRequest, encodeHelloReply } from './Greeter.js';
+import { decodeEnvelope, encodeEnvelope, encodeErrorEnvelope } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * 추상 클래스 (C#의 GreeterBase)
+ * 사용자(서버구현자)는 이 클래스를 상속해서 실제 로직을 override한다.
+ * Abstract class (like C#'s GreeterBase)
+ * Users (server implementors) should inherit this class and override the methods.
+ */
+export class GreeterBase {
+  
+  /**
+   * async SayHello
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    throw new Error("Method SayHello must be implemented");
+  }
+  
+}
+
+/**
+ * static BindService, (C#의 Greeter.BindService(impl))
+ * - impl: GreeterBase implementation
+ * - return: ServiceDefinition(methodHandlers)
+ */
+export class Greeter {
+  static bindService(impl) {
+    const def = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Greeter.SayHello"] = async (reqBytes) => {
+      const envelope = decodeEnvelope(reqBytes, "Greeter", "SayHello");
+      try {
+        const reqObj = decodeHelloRequest(envelope.payload);
+        const respObj = await impl.SayHello(reqObj);
+        return encodeEnvelope("Greeter", "SayHello", envelope.requestId, encodeHelloReply(respObj));
+      } catch (e) {
+        // failures travel in the response envelope, see openEnvelope
+        return encodeErrorEnvelope("Greeter", "SayHello", envelope.requestId, e && e.message ? e.message : String(e));
+      }
+    };
+    
+
+    return def;
+  }
+}
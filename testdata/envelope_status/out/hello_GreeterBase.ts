@@ -0,0 +1,73 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Server: GreeterServiceBase
+
+// Import encoding/decoding functions for each method
+import { decodeHelloRequest, encodeHelloReply } from './Greeter';
+import { decodeEnvelope, encodeEnvelope, encodeErrorEnvelope } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * Service definition structure
+ */
+export interface ServiceDefinition {
+  methodHandlers: {
+    [key: string]: (reqBytes: Uint8Array) => Promise<Uint8Array>;
+  };
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Abstract class for Greeter server implementation
+ * Users (server implementors) should inherit this class and implement the methods.
+ */
+export abstract class GreeterBase {
+  
+  /**
+   * SayHello method
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  abstract SayHello(requestObj: HelloRequest): Promise<HelloReply>;
+  
+}
+
+/**
+ * Service binding utility
+ * Binds a service implementation to create a ServiceDefinition
+ */
+export class Greeter {
+  static bindService(impl: GreeterBase): ServiceDefinition {
+    const def: ServiceDefinition = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Greeter.SayHello"] = async (reqBytes: Uint8Array): Promise<Uint8Array> => {
+      const envelope = decodeEnvelope(reqBytes, "Greeter", "SayHello");
+      try {
+        const reqObj = decodeHelloRequest(envelope.payload);
+        const respObj = await impl.SayHello(reqObj);
+        return encodeEnvelope("Greeter", "SayHello", envelope.requestId, encodeHelloReply(respObj));
+      } catch (e) {
+        // failures travel in the response envelope, see openEnvelope
+        return encodeErrorEnvelope("Greeter", "SayHello", envelope.requestId, e instanceof Error ? e.message : String(e));
+      }
+    };
+    
+
+    return def;
+  }
+}
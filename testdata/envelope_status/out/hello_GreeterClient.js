@@ -0,0 +1,42 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { newRequestId, encodeEnvelope, openEnvelope } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const requestId = newRequestId();
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", encodeEnvelope("Greeter", "SayHello", requestId, reqBytes));
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(openEnvelope(respBytes, "Greeter", "SayHello", requestId));
+    return respObj;
+  }
+  
+}
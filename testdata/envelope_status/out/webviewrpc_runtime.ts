@@ -0,0 +1,130 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Request or response wrapped by gen_envelope, naming the call it belongs to
+ */
+export interface RpcCallEnvelope {
+  service: string;
+  method: string;
+  requestId: string;
+  /** 0 = ok, 1 = error (responses only) */
+  status: number;
+  /** encoded request or response message, or UTF-8 error message */
+  payload: Uint8Array;
+}
+
+/**
+ * Raised by openEnvelope for a response envelope with the error status: the
+ * server method failed with message
+ */
+export class RpcCallError extends Error {
+  readonly service: string;
+  readonly method: string;
+  readonly requestId: string;
+
+  constructor(service: string, method: string, requestId: string, message: string) {
+    super(`RPC call ${service}.${method} failed: ${message}`);
+    this.name = "RpcCallError";
+    this.service = service;
+    this.method = method;
+    this.requestId = requestId;
+  }
+}
+
+/**
+ * Creates the id pairing a request envelope with its response
+ */
+export function newRequestId(): string {
+  if (typeof crypto !== "undefined" && crypto.randomUUID) {
+    return crypto.randomUUID();
+  }
+  return Date.now().toString(16) + Math.random().toString(16).slice(2);
+}
+
+/**
+ * Wraps a payload in an envelope: service, method and request id (each uint32
+ * length + UTF-8), a status byte (0 = ok, 1 = error with a UTF-8 message as
+ * payload), then the payload (uint32 length + bytes), little-endian
+ */
+export function encodeEnvelope(service: string, method: string, requestId: string, payload: Uint8Array, status: number = 0): Uint8Array {
+  const encoder = new TextEncoder();
+  const parts = [encoder.encode(service), encoder.encode(method), encoder.encode(requestId), payload];
+  const out = new Uint8Array(parts.reduce((size, part) => size + 4 + part.length, 1));
+  const view = new DataView(out.buffer);
+  let pos = 0;
+  parts.forEach((part, i) => {
+    if (i === 3) {
+      out[pos++] = status;
+    }
+    view.setUint32(pos, part.length, true);
+    out.set(part, pos + 4);
+    pos += 4 + part.length;
+  });
+  return out;
+}
+
+/**
+ * Response envelope reporting that the server method failed with message
+ */
+export function encodeErrorEnvelope(service: string, method: string, requestId: string, message: string): Uint8Array {
+  return encodeEnvelope(service, method, requestId, new TextEncoder().encode(message), 1);
+}
+
+/**
+ * Decodes an envelope, throwing when it was sent for another method
+ */
+export function decodeEnvelope(bytes: Uint8Array, service: string, method: string): RpcCallEnvelope {
+  const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+  const parts: Uint8Array[] = [];
+  let status = 0;
+  let pos = 0;
+  for (let i = 0; i < 4; i++) {
+    if (i === 3) {
+      if (pos >= bytes.length) {
+        throw new Error("Truncated envelope");
+      }
+      status = bytes[pos++];
+    }
+    if (pos + 4 > bytes.length) {
+      throw new Error("Truncated envelope");
+    }
+    const length = view.getUint32(pos, true);
+    pos += 4;
+    if (pos + length > bytes.length) {
+      throw new Error("Truncated envelope");
+    }
+    parts.push(bytes.subarray(pos, pos + length));
+    pos += length;
+  }
+  const decoder = new TextDecoder();
+  const envelope: RpcCallEnvelope = {
+    service: decoder.decode(parts[0]),
+    method: decoder.decode(parts[1]),
+    requestId: decoder.decode(parts[2]),
+    status,
+    payload: parts[3],
+  };
+  if (envelope.service !== service || envelope.method !== method) {
+    throw new Error(`Envelope of ${envelope.service}.${envelope.method} received by ${service}.${method}`);
+  }
+  return envelope;
+}
+
+/**
+ * Payload of a response envelope, throwing when it answers another request and
+ * RpcCallError when it carries the error status
+ */
+export function openEnvelope(bytes: Uint8Array, service: string, method: string, requestId: string): Uint8Array {
+  const envelope = decodeEnvelope(bytes, service, method);
+  if (envelope.requestId !== requestId) {
+    throw new Error(`Response to request ${envelope.requestId} received for request ${requestId}`);
+  }
+  if (envelope.status === 1) {
+    throw new RpcCallError(service, method, requestId, new TextDecoder().decode(envelope.payload));
+  }
+  if (envelope.status !== 0) {
+    throw new Error(`Unknown envelope status ${envelope.status}`);
+  }
+  return envelope.payload;
+}
@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Ext
+{
+    public interface IExtClient
+    {
+        
+        UniTask<Resp> Go(Req request);
+        
+    }
+
+    public class ExtClient : IExtClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "ext.Ext";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public ExtClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Req and returns a Resp.
+        /// </summary>
+        public async UniTask<Resp> Go(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Resp>("Ext.Go", request);
+            return response;
+        }
+        
+    }
+}
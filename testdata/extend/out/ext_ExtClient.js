@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: ExtClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeResp } from './Ext.js';
+
+/**
+ * Fully-qualified proto name of Ext, for routing and logging
+ */
+export const ExtServiceName = "ext.Ext";
+
+export class ExtClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Go
+   * Sends a Req and returns a Resp.
+   * @param { Req } requestObj
+   * @returns {Promise< Resp >}
+   */
+  async Go(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeReq(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Ext.Go", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,113 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: FeedClient
+
+// Import encoding/decoding functions for each method
+import { encodeTopic, decodeUpdate } from './Feed';
+import { RpcSubscription, subscribeWithBackpressure, encodeFlowControl } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface Topic {
+  [key: string]: any;
+}
+
+export interface Update {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+  callServerStreamingMethod(methodName: string, reqBytes: Uint8Array, onMessage: (respBytes: Uint8Array) => void): () => void;
+}
+
+/**
+ * Fully-qualified proto name of Feed, for routing and logging
+ */
+export const FeedServiceName = "live.Feed";
+
+/**
+ * Server-streaming methods of Feed mapped to the response type they emit
+ */
+export interface FeedStreamEventMap {
+  Subscribe: Update;
+}
+
+/**
+ * Server-streaming methods of Feed mapped to their request type
+ */
+export interface FeedStreamRequestMap {
+  Subscribe: Topic;
+}
+
+/**
+ * Feed RPC Client
+ * Provides type-safe methods to call Feed on the server
+ */
+export class FeedClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param method - name of the streaming method, see FeedStreamEventMap
+   * @param requestObj - request object of the method
+   * @param callback - invoked with each decoded response
+   * @returns function that cancels the subscription, with pause() and resume()
+   */
+  subscribe<K extends keyof FeedStreamEventMap>(
+    method: K,
+    requestObj: FeedStreamRequestMap[K],
+    callback: (response: FeedStreamEventMap[K]) => void
+  ): RpcSubscription {
+    const codecs: {
+      [M in keyof FeedStreamEventMap]: [
+        (obj: FeedStreamRequestMap[M]) => Uint8Array,
+        (bytes: Uint8Array) => FeedStreamEventMap[M]
+      ];
+    } = {
+      Subscribe: [encodeTopic, decodeUpdate],
+    };
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    const start = (onFrame: (respBytes: Uint8Array) => void): (() => void) => this.rpcClient.callServerStreamingMethod(
+      "Feed." + method,
+      reqBytes,
+      onFrame
+    );
+    // gen_backpressure: pause()/resume() buffer the frames and ask the server to hold them back
+    return subscribeWithBackpressure(
+      start,
+      (action) => {
+        // fire and forget like $cancel: a server without "$flow" keeps sending
+        this.rpcClient.callMethod("Feed.$flow", encodeFlowControl("Feed." + method, reqBytes, action)).catch(() => undefined);
+      },
+      (respBytes) => callback(decode(respBytes))
+    );
+  }
+
+  
+  /**
+   * Call Get method
+   * Sends a Topic and returns an Update.
+   * @param requestObj - Topic object
+   * @returns Promise resolving to Update
+   */
+  async Get(requestObj: Topic): Promise<Update> {
+    // Encode request object to bytes
+    const reqBytes = encodeTopic(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Feed.Get", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeUpdate(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,68 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Subscription to a server-streaming method with gen_backpressure: calling it
+ * cancels the subscription, pause() holds back the responses and resume()
+ * delivers them again
+ */
+export type RpcSubscription = (() => void) & { pause(): void; resume(): void };
+
+/**
+ * Encodes a "<Service>.$flow" control frame asking the server to stop (action
+ * 1) or restart (action 0) sending the frames of a stream: the full method name
+ * (uint32 length + UTF-8), the request the stream was started with (uint32
+ * length + bytes) and the action byte, little-endian
+ */
+export function encodeFlowControl(method: string, reqBytes: Uint8Array, action: 0 | 1): Uint8Array {
+  const methodBytes = new TextEncoder().encode(method);
+  const out = new Uint8Array(9 + methodBytes.length + reqBytes.length);
+  const view = new DataView(out.buffer);
+  view.setUint32(0, methodBytes.length, true);
+  out.set(methodBytes, 4);
+  const pos = 4 + methodBytes.length;
+  view.setUint32(pos, reqBytes.length, true);
+  out.set(reqBytes, pos + 4);
+  out[pos + 4 + reqBytes.length] = action;
+  return out;
+}
+
+/**
+ * Starts a stream whose frames can be held back: while paused, frames that
+ * still arrive are buffered and handed to onFrame in order on resume
+ */
+export function subscribeWithBackpressure(
+  start: (onFrame: (respBytes: Uint8Array) => void) => () => void,
+  sendControl: (action: 0 | 1) => void,
+  onFrame: (respBytes: Uint8Array) => void
+): RpcSubscription {
+  let paused = false;
+  const buffered: Uint8Array[] = [];
+  const cancel = start((respBytes) => {
+    if (paused) {
+      buffered.push(respBytes);
+    } else {
+      onFrame(respBytes);
+    }
+  });
+  return Object.assign(() => {
+    buffered.length = 0;
+    cancel();
+  }, {
+    pause(): void {
+      if (!paused) {
+        paused = true;
+        sendControl(1);
+      }
+    },
+    resume(): void {
+      if (paused) {
+        paused = false;
+        while (buffered.length > 0 && !paused) {
+          onFrame(buffered.shift()!);
+        }
+        sendControl(0);
+      }
+    },
+  });
+}
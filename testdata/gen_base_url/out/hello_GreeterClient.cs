@@ -0,0 +1,54 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+        private readonly string _baseUrl;
+
+        /// <param name="baseUrl">Prefix of the transport endpoints, e.g. "https://api.example.com"; empty for the plain "Greeter.Method" names.</param>
+        public GreeterClient(WebViewRpcClient rpcClient, string baseUrl = "")
+        {
+            this._rpcClient = rpcClient;
+            this._baseUrl = (baseUrl ?? "").TrimEnd('/');
+        }
+
+        /// <summary>
+        /// Name of method for the transport: "Greeter.Method", or the path "/helloworld.Greeter/Method" under the base URL.
+        /// </summary>
+        private string Endpoint(string method)
+        {
+            return _baseUrl.Length == 0 ? "Greeter." + method : _baseUrl + "/helloworld.Greeter/" + method;
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            var response = await _rpcClient.CallMethod<HelloReply>(Endpoint("SayHello"), request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,51 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   * @param {string} [baseUrl] prefix of the transport endpoints, e.g. "https://api.example.com"; empty for the plain "Greeter.Method" names
+   */
+  constructor(rpcClient, baseUrl = "") {
+    this.rpcClient = rpcClient;
+    this.baseUrl = baseUrl.replace(/\/+$/, "");
+  }
+
+  /**
+   * Name of method for the transport: "Greeter.Method", or the path "/helloworld.Greeter/Method" under the base URL
+   * @param {string} method
+   * @returns {string}
+   */
+  endpoint(method) {
+    return this.baseUrl === "" ? `Greeter.${method}` : `${this.baseUrl}/helloworld.Greeter/${method}`;
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod(this.endpoint("SayHello"), reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,74 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+  /** prefix of the transport endpoints, without trailing "/" */
+  private baseUrl: string;
+
+  /**
+   * @param rpcClient - transport of the calls
+   * @param baseUrl - prefix of the transport endpoints, e.g. "https://api.example.com"; empty for the plain "Greeter.Method" names
+   */
+  constructor(rpcClient: WebViewRpcClient, baseUrl: string = "") {
+    this.rpcClient = rpcClient;
+    this.baseUrl = baseUrl.replace(/\/+$/, "");
+  }
+
+  /**
+   * Name of method for the transport: "Greeter.Method", or the path "/helloworld.Greeter/Method" under the base URL
+   */
+  private endpoint(method: string): string {
+    return this.baseUrl === "" ? `Greeter.${method}` : `${this.baseUrl}/helloworld.Greeter/${method}`;
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod(this.endpoint("SayHello"), reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,238 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { withTimeout, encodeBatchCalls, decodeBatchResults, RpcTimeoutError, newRequestId, encodeEnvelope, openEnvelope, OfflineQueue, transportConnected, compressEnvelope, decompressEnvelope } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * Most unary calls the client has in flight at once; further calls wait in a FIFO queue.
+   */
+  static MAX_CONCURRENT = 2;
+
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   * @param {import('./webviewrpc_runtime.js').RpcSigner} [signer] signs the request of each unary call, which is sent unsigned without one
+   * @param {import('./webviewrpc_runtime.js').RpcQueueStorage} [queueStorage] persists the calls queued while the transport is offline, which are kept in memory only without one
+   * @param {string} [queueKey] key of the queue in queueStorage; clients of Greeter sharing a storage each need their own
+   */
+  constructor(rpcClient, signer = undefined, queueStorage = undefined, queueKey = "webviewrpc.queue." + GreeterServiceName) {
+    this.rpcClient = rpcClient;
+    this.signer = signer;
+    this.activeCalls = 0;
+    /** @type {Array<() => void>} starts of the calls waiting for a slot, oldest first */
+    this.queuedCalls = [];
+    /** unary calls made while the transport is offline */
+    this.offlineQueue = new OfflineQueue(queueStorage, queueKey, () => transportConnected(this.rpcClient), (method, payload, ...args) =>
+      this.limited(() => this.rpcClient.callMethod(method, payload, ...args))
+    );
+    if (typeof globalThis.addEventListener === "function") {
+      globalThis.addEventListener("online", () => this.flushQueue().catch(() => {}));
+    }
+    // sends the calls queued in an earlier session
+    this.flushQueue().catch(() => {});
+  }
+
+  /**
+   * Sends the calls queued while the transport was offline, in order. Runs by
+   * itself when the browser goes online and with
+   * the next call; call it when the transport is back otherwise, e.g. the
+   * native bridge.
+   * @returns {Promise<void>} rejects when the queue storage fails
+   */
+  flushQueue() {
+    return this.offlineQueue.flush();
+  }
+
+  /**
+   * Signature of the encoded request of method ("Greeter.Method"), empty without a signer
+   * @param {string} method
+   * @param {Uint8Array} reqBytes
+   * @returns {Promise<Uint8Array>}
+   */
+  async sign(method, reqBytes) {
+    return this.signer ? await this.signer(method, reqBytes) : new Uint8Array(0);
+  }
+
+  /**
+   * Runs send once fewer than MAX_CONCURRENT calls are in flight, in the order the calls were made
+   * @template T
+   * @param {() => Promise<T>} send
+   * @returns {Promise<T>}
+   */
+  limited(send) {
+    return new Promise((resolve, reject) => {
+      const start = () => {
+        this.activeCalls++;
+        Promise.resolve()
+          .then(send)
+          .then(resolve, reject)
+          .finally(() => {
+            this.activeCalls--;
+            const next = this.queuedCalls.shift();
+            if (next) {
+              next();
+            }
+          });
+      };
+      if (this.activeCalls < GreeterClient.MAX_CONCURRENT) {
+        start();
+      } else {
+        this.queuedCalls.push(start);
+      }
+    });
+  }
+
+  /**
+   * Starts a batch: calls queued on it are sent together in a single
+   * "Greeter.$batch" round trip by send().
+   * @returns { GreeterBatch }
+   */
+  batch() {
+    return new GreeterBatch((reqBytes) => this.sendBatch(reqBytes), (method, reqBytes) => this.sign(method, reqBytes));
+  }
+
+  /**
+   * Sends the encoded calls of a batch as one "Greeter.$batch" call,
+   * the way a unary call is sent.
+   * The batch takes one slot of max_concurrent.
+   * While the transport is offline, the batch waits in the offline queue.
+   * @param {Uint8Array} reqBytes
+   * @returns {Promise<Uint8Array>}
+   */
+  sendBatch(reqBytes) {
+    return this.offlineQueue.call("Greeter.$batch", reqBytes);
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @param {number} [timeoutMs=5000] call timeout, 0 disables it
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj, timeoutMs = 5000) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    // gen_offline_queue: while the transport is offline the call waits in the persistent queue
+    const requestId = newRequestId();
+    const signature = await this.sign("Greeter.SayHello", reqBytes);
+    // compression=gzip: the request payload travels gzip-compressed, flagged in the envelope
+    const reqEnvelope = await compressEnvelope(encodeEnvelope("Greeter", "SayHello", requestId, reqBytes, 0, signature));
+    let call = this.offlineQueue.call("Greeter.SayHello", reqEnvelope);
+    const queued = call;
+    call = withTimeout(call, timeoutMs, "Greeter.SayHello");
+    // a call given up on while it waits in the offline queue is taken out rather than sent later
+    call = call.catch((e) => {
+      this.offlineQueue.remove(queued).catch(() => {});
+      throw e;
+    });
+    const respBytes = await call;
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(openEnvelope(await decompressEnvelope(respBytes), "Greeter", "SayHello", requestId));
+    return respObj;
+  }
+  
+}
+
+/**
+ * Calls of Greeter queued for one batched round trip, see GreeterClient.batch().
+ * Each queued call returns a promise settled once send() gets the batch response.
+ */
+export class GreeterBatch {
+  /**
+   * @param {(reqBytes: Uint8Array) => Promise<Uint8Array>} sendBatch sends the encoded calls as one "Greeter.$batch" call
+   * @param {(method: string, reqBytes: Uint8Array) => Promise<Uint8Array>} sign signature of each queued request
+   */
+  constructor(sendBatch, sign) {
+    this.sendBatch = sendBatch;
+    this.sign = sign;
+    /** @type {Array<{ method: string, reqBytes: Promise<Uint8Array>, decode: (bytes: Uint8Array) => Promise<Object>, resolve: (value: Object) => void, reject: (reason: Error) => void, timeoutMs: number }>} */
+    this.calls = [];
+    this.sent = false;
+  }
+  
+  /**
+   * Queues SayHello
+   * @param { HelloRequest } requestObj
+   * @param {number} [timeoutMs=5000] call timeout counted from send(), 0 disables it
+   * @returns {Promise< HelloReply >}
+   */
+  SayHello(requestObj, timeoutMs = 5000) {
+    const requestId = newRequestId();
+    const reqBytes = encodeHelloRequest(requestObj);
+    return this.enqueue(
+      "Greeter.SayHello",
+      this.sign("Greeter.SayHello", reqBytes).then((signature) => compressEnvelope(encodeEnvelope("Greeter", "SayHello", requestId, reqBytes, 0, signature))),
+      async (respBytes) => decodeHelloReply(openEnvelope(await decompressEnvelope(respBytes), "Greeter", "SayHello", requestId)),
+      timeoutMs
+    );
+  }
+  
+  enqueue(method, reqBytes, decode, timeoutMs) {
+    if (this.sent) {
+      throw new Error("GreeterBatch has already been sent");
+    }
+    return new Promise((resolve, reject) => {
+      this.calls.push({ method, reqBytes, decode, resolve, reject, timeoutMs });
+    });
+  }
+
+  /**
+   * Sends every queued call in one round trip. A failed round trip rejects every
+   * queued call; otherwise each call settles with its own result.
+   * @returns {Promise<void>} resolves once every queued call has settled
+   */
+  async send() {
+    if (this.sent) {
+      throw new Error("GreeterBatch has already been sent");
+    }
+    this.sent = true;
+    const calls = this.calls;
+    if (calls.length === 0) {
+      return;
+    }
+    // each call's timeout runs from here; a call that times out stops waiting, the others keep waiting for the batch
+    const timers = calls.filter((call) => call.timeoutMs > 0)
+      .map((call) => setTimeout(() => call.reject(new RpcTimeoutError(call.method, call.timeoutMs)), call.timeoutMs));
+    let results;
+    try {
+      // the requests are signed and compressed while queued, a failed signature fails the whole batch
+      for (const call of calls) {
+        call.reqBytes = await call.reqBytes;
+      }
+      const reqBytes = encodeBatchCalls(calls);
+      results = decodeBatchResults(await this.sendBatch(reqBytes));
+    } catch (e) {
+      calls.forEach((call) => call.reject(e));
+      return;
+    } finally {
+      timers.forEach(clearTimeout);
+    }
+    calls.forEach((call, id) => {
+      const result = results.get(id);
+      if (!result) {
+        call.reject(new Error(`No response for batched call ${call.method}`));
+      } else if (!result.ok) {
+        call.reject(new Error(result.error));
+      } else {
+        try {
+          call.resolve(call.decode(result.payload));
+        } catch (e) {
+          call.reject(e);
+        }
+      }
+    });
+  }
+}
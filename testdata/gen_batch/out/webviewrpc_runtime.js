@@ -0,0 +1,566 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Raised when a call does not complete within its timeout.
+ */
+export class RpcTimeoutError extends Error {
+  /**
+   * @param {string} method
+   * @param {number} timeoutMs
+   */
+  constructor(method, timeoutMs) {
+    super(`RPC call ${method} timed out after ${timeoutMs} ms`);
+    this.name = "RpcTimeoutError";
+    this.method = method;
+    this.timeoutMs = timeoutMs;
+  }
+}
+
+/**
+ * Rejects with RpcTimeoutError when call does not settle within timeoutMs.
+ * A timeoutMs of 0 or less disables the timeout.
+ * @template T
+ * @param {Promise<T>} call
+ * @param {number} timeoutMs
+ * @param {string} method
+ * @returns {Promise<T>}
+ */
+export function withTimeout(call, timeoutMs, method) {
+  if (!(timeoutMs > 0)) {
+    return call;
+  }
+  let timer;
+  const timeout = new Promise((_, reject) => {
+    timer = setTimeout(() => reject(new RpcTimeoutError(method, timeoutMs)), timeoutMs);
+  });
+  return Promise.race([call, timeout]).finally(() => clearTimeout(timer));
+}
+
+/**
+ * Encodes the calls of a batch as the payload of a "<Service>.$batch" call:
+ * per call, its index (uint32), method name (uint32 length + UTF-8) and
+ * request (uint32 length + bytes), little-endian.
+ * @param {Array<{ method: string, reqBytes: Uint8Array }>} calls
+ * @returns {Uint8Array}
+ */
+export function encodeBatchCalls(calls) {
+  const encoder = new TextEncoder();
+  const methods = calls.map((call) => encoder.encode(call.method));
+  let size = 0;
+  calls.forEach((call, id) => {
+    size += 12 + methods[id].length + call.reqBytes.length;
+  });
+  const out = new Uint8Array(size);
+  const view = new DataView(out.buffer);
+  let pos = 0;
+  calls.forEach((call, id) => {
+    view.setUint32(pos, id, true);
+    view.setUint32(pos + 4, methods[id].length, true);
+    out.set(methods[id], pos + 8);
+    pos += 8 + methods[id].length;
+    view.setUint32(pos, call.reqBytes.length, true);
+    out.set(call.reqBytes, pos + 4);
+    pos += 4 + call.reqBytes.length;
+  });
+  return out;
+}
+
+/**
+ * Decodes the response of a "<Service>.$batch" call: per call, its index
+ * (uint32), a status byte (0 = ok, 1 = error) and a payload (uint32 length +
+ * response bytes, or UTF-8 error message), little-endian.
+ * @param {Uint8Array} bytes
+ * @returns {Map<number, { ok: boolean, payload?: Uint8Array, error?: string }>} results by call index
+ */
+export function decodeBatchResults(bytes) {
+  const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+  const decoder = new TextDecoder();
+  const results = new Map();
+  let pos = 0;
+  while (pos < bytes.length) {
+    if (pos + 9 > bytes.length) {
+      throw new Error("Truncated batch response");
+    }
+    const id = view.getUint32(pos, true);
+    const ok = bytes[pos + 4] === 0;
+    const length = view.getUint32(pos + 5, true);
+    pos += 9;
+    if (pos + length > bytes.length) {
+      throw new Error("Truncated batch response");
+    }
+    const payload = bytes.subarray(pos, pos + length);
+    pos += length;
+    results.set(id, ok ? { ok, payload } : { ok, error: decoder.decode(payload) });
+  }
+  return results;
+}
+
+/**
+ * Request or response wrapped by gen_envelope, naming the call it belongs to.
+ * @typedef {Object} RpcCallEnvelope
+ * @property {string} service
+ * @property {string} method
+ * @property {string} requestId pairs a response with its request
+ * @property {number} status 0 = ok, 1 = error (responses only)
+ * @property {Uint8Array} payload encoded request or response message, or UTF-8 error message
+ * @property {Uint8Array} signature returned by the RpcSigner of the client, empty when unsigned
+ */
+
+/**
+ * Signs the encoded request of a unary call; method is "<Service>.<Method>".
+ * The returned bytes travel in the envelope and are checked by the
+ * verifySignature override of the server.
+ * @callback RpcSigner
+ * @param {string} method
+ * @param {Uint8Array} payload
+ * @returns {Uint8Array|Promise<Uint8Array>}
+ */
+
+/**
+ * Raised by openEnvelope for a response envelope with the error status: the
+ * server method failed with message.
+ */
+export class RpcCallError extends Error {
+  /**
+   * @param {string} service
+   * @param {string} method
+   * @param {string} requestId
+   * @param {string} message
+   */
+  constructor(service, method, requestId, message) {
+    super(`RPC call ${service}.${method} failed: ${message}`);
+    this.name = "RpcCallError";
+    this.service = service;
+    this.method = method;
+    this.requestId = requestId;
+  }
+}
+
+/**
+ * Creates the id pairing a request envelope with its response.
+ * @returns {string}
+ */
+export function newRequestId() {
+  if (typeof crypto !== "undefined" && crypto.randomUUID) {
+    return crypto.randomUUID();
+  }
+  return Date.now().toString(16) + Math.random().toString(16).slice(2);
+}
+
+/**
+ * Wraps a payload in the envelope of gen_envelope: service, method and request
+ * id (each uint32 length + UTF-8), a status byte, then the payload (uint32
+ * length + bytes), little-endian. A non-empty signature follows the payload
+ * as uint32 length + bytes.
+ * @param {string} service
+ * @param {string} method
+ * @param {string} requestId
+ * @param {Uint8Array} payload
+ * @param {number} [status=0] 0 = ok, 1 = error with a UTF-8 message as payload
+ * @param {Uint8Array} [signature]
+ * @returns {Uint8Array}
+ */
+export function encodeEnvelope(service, method, requestId, payload, status = 0, signature = undefined) {
+  const encoder = new TextEncoder();
+  const parts = [encoder.encode(service), encoder.encode(method), encoder.encode(requestId), payload];
+  if (signature && signature.length > 0) {
+    parts.push(signature);
+  }
+  const out = new Uint8Array(parts.reduce((size, part) => size + 4 + part.length, 1));
+  const view = new DataView(out.buffer);
+  let pos = 0;
+  parts.forEach((part, i) => {
+    if (i === 3) {
+      out[pos++] = status;
+    }
+    view.setUint32(pos, part.length, true);
+    out.set(part, pos + 4);
+    pos += 4 + part.length;
+  });
+  return out;
+}
+
+/**
+ * Response envelope reporting that the server method failed with message.
+ * @param {string} service
+ * @param {string} method
+ * @param {string} requestId
+ * @param {string} message
+ * @returns {Uint8Array}
+ */
+export function encodeErrorEnvelope(service, method, requestId, message) {
+  return encodeEnvelope(service, method, requestId, new TextEncoder().encode(message), 1);
+}
+
+/**
+ * Decodes an envelope, throwing when it was sent for another method.
+ * @param {Uint8Array} bytes
+ * @param {string} service
+ * @param {string} method
+ * @returns {RpcCallEnvelope}
+ */
+export function decodeEnvelope(bytes, service, method) {
+  const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+  const parts = [];
+  let status = 0;
+  let pos = 0;
+  for (let i = 0; i < 4 || (i === 4 && pos < bytes.length); i++) {
+    if (i === 3) {
+      if (pos >= bytes.length) {
+        throw new Error("Truncated envelope");
+      }
+      status = bytes[pos++];
+    }
+    if (pos + 4 > bytes.length) {
+      throw new Error("Truncated envelope");
+    }
+    const length = view.getUint32(pos, true);
+    pos += 4;
+    if (pos + length > bytes.length) {
+      throw new Error("Truncated envelope");
+    }
+    parts.push(bytes.subarray(pos, pos + length));
+    pos += length;
+  }
+  const decoder = new TextDecoder();
+  const envelope = {
+    service: decoder.decode(parts[0]),
+    method: decoder.decode(parts[1]),
+    requestId: decoder.decode(parts[2]),
+    status,
+    payload: parts[3],
+    signature: parts[4] || new Uint8Array(0),
+  };
+  if (envelope.service !== service || envelope.method !== method) {
+    throw new Error(`Envelope of ${envelope.service}.${envelope.method} received by ${service}.${method}`);
+  }
+  return envelope;
+}
+
+/**
+ * Payload of a response envelope, throwing when it answers another request and
+ * RpcCallError when it carries the error status.
+ * @param {Uint8Array} bytes
+ * @param {string} service
+ * @param {string} method
+ * @param {string} requestId
+ * @returns {Uint8Array}
+ */
+export function openEnvelope(bytes, service, method, requestId) {
+  const envelope = decodeEnvelope(bytes, service, method);
+  if (envelope.requestId !== requestId) {
+    throw new Error(`Response to request ${envelope.requestId} received for request ${requestId}`);
+  }
+  if (envelope.status === 1) {
+    throw new RpcCallError(service, method, requestId, new TextDecoder().decode(envelope.payload));
+  }
+  if (envelope.status !== 0) {
+    throw new Error(`Unknown envelope status ${envelope.status}`);
+  }
+  return envelope.payload;
+}
+
+/**
+ * Bit of the status byte of an envelope whose payload is gzip-compressed
+ * (compression=gzip).
+ */
+export const ENVELOPE_GZIP = 0x80;
+
+/**
+ * Offset of the status byte of an encoded envelope, after service, method and request id.
+ * @param {Uint8Array} bytes
+ * @returns {number}
+ */
+function envelopeStatusOffset(bytes) {
+  const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+  let pos = 0;
+  for (let i = 0; i < 3; i++) {
+    if (pos + 4 > bytes.length) {
+      throw new Error("Truncated envelope");
+    }
+    pos += 4 + view.getUint32(pos, true);
+  }
+  if (pos + 5 > bytes.length || pos + 5 + view.getUint32(pos + 1, true) > bytes.length) {
+    throw new Error("Truncated envelope");
+  }
+  return pos;
+}
+
+/**
+ * Runs bytes through a CompressionStream or DecompressionStream.
+ * @param {Uint8Array} bytes
+ * @param {CompressionStream | DecompressionStream} transform
+ * @returns {Promise<Uint8Array>}
+ */
+async function transformBytes(bytes, transform) {
+  const input = new ReadableStream({
+    start(controller) {
+      controller.enqueue(bytes);
+      controller.close();
+    },
+  });
+  return new Uint8Array(await new Response(input.pipeThrough(transform)).arrayBuffer());
+}
+
+/**
+ * Copy of an encoded envelope with another status byte and payload.
+ * @param {Uint8Array} bytes
+ * @param {number} statusPos
+ * @param {number} status
+ * @param {Uint8Array} payload
+ * @returns {Uint8Array}
+ */
+function replacePayload(bytes, statusPos, status, payload) {
+  const oldLength = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength).getUint32(statusPos + 1, true);
+  const trailing = bytes.subarray(statusPos + 5 + oldLength);
+  const out = new Uint8Array(statusPos + 5 + payload.length + trailing.length);
+  out.set(bytes.subarray(0, statusPos));
+  out[statusPos] = status;
+  new DataView(out.buffer).setUint32(statusPos + 1, payload.length, true);
+  out.set(payload, statusPos + 5);
+  out.set(trailing, statusPos + 5 + payload.length);
+  return out;
+}
+
+/**
+ * Gzip-compresses the payload of an encoded envelope and sets ENVELOPE_GZIP in its status byte.
+ * @param {Uint8Array} bytes
+ * @returns {Promise<Uint8Array>}
+ */
+export async function compressEnvelope(bytes) {
+  const pos = envelopeStatusOffset(bytes);
+  const length = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength).getUint32(pos + 1, true);
+  const payload = await transformBytes(bytes.subarray(pos + 5, pos + 5 + length), new CompressionStream("gzip"));
+  return replacePayload(bytes, pos, bytes[pos] | ENVELOPE_GZIP, payload);
+}
+
+/**
+ * Decompresses the payload of an encoded envelope flagged with ENVELOPE_GZIP and
+ * clears the flag; other envelopes are returned as they are.
+ * @param {Uint8Array} bytes
+ * @returns {Promise<Uint8Array>}
+ */
+export async function decompressEnvelope(bytes) {
+  const pos = envelopeStatusOffset(bytes);
+  if ((bytes[pos] & ENVELOPE_GZIP) === 0) {
+    return bytes;
+  }
+  const length = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength).getUint32(pos + 1, true);
+  const payload = await transformBytes(bytes.subarray(pos + 5, pos + 5 + length), new DecompressionStream("gzip"));
+  return replacePayload(bytes, pos, bytes[pos] & ~ENVELOPE_GZIP, payload);
+}
+
+/**
+ * Response envelope bytes compressed when the request envelope was, so that
+ * clients only get compressed responses to compressed requests.
+ * @param {Uint8Array} bytes encoded response envelope
+ * @param {Uint8Array} request encoded request envelope, as received
+ * @returns {Promise<Uint8Array>}
+ */
+export async function compressEnvelopeLike(bytes, request) {
+  return (request[envelopeStatusOffset(request)] & ENVELOPE_GZIP) !== 0 ? compressEnvelope(bytes) : bytes;
+}
+
+/**
+ * Storage the offline queue persists its calls in. localStorage qualifies;
+ * asynchronous stores such as IndexedDB or native storage through the bridge
+ * may return promises.
+ * @typedef {Object} RpcQueueStorage
+ * @property {function(string): (?string | Promise<?string>)} getItem
+ * @property {function(string, string): (void | Promise<void>)} setItem
+ */
+
+/**
+ * Whether rpcClient can reach the other side now: its isConnected() if it has
+ * one, e.g. to report the native bridge, else whether its WebSocket (socket)
+ * is open, else navigator.onLine.
+ * @param {Object} rpcClient
+ * @returns {boolean}
+ */
+export function transportConnected(rpcClient) {
+  if (typeof rpcClient.isConnected === "function") {
+    return rpcClient.isConnected();
+  }
+  if (rpcClient.socket) {
+    return rpcClient.socket.readyState === 1; // WebSocket.OPEN
+  }
+  return typeof navigator === "undefined" || navigator.onLine !== false;
+}
+
+/**
+ * Persistent FIFO queue of the unary calls a client makes while its transport
+ * is offline. Calls go straight to the transport while it is connected and
+ * nothing is queued. Otherwise they are appended to the queue, saved to
+ * storage under key, and settle once flush() sent them, one at a time in
+ * order. A call leaves the queue only after its response arrived, so it may
+ * be sent again if the app stops in between: delivery is at least once. Calls
+ * restored from storage, whose callers are gone, go first and their responses
+ * are dropped. A call given up on while queued is taken out with remove().
+ */
+export class OfflineQueue {
+  /**
+   * @param {RpcQueueStorage | undefined} storage the queue is kept in memory only without one
+   * @param {string} key
+   * @param {() => boolean} isConnected
+   * @param {function(string, Uint8Array, ...*): Promise<Uint8Array>} send transport call with the method, the request and further arguments
+   */
+  constructor(storage, key, isConnected, send) {
+    this.storage = storage;
+    this.key = key;
+    this.isConnected = isConnected;
+    this.send = send;
+    /** @type {Array<{ method: string, payload: Uint8Array, args: Array<*>, resolve: function(Uint8Array): void, reject: function(*): void, removed?: boolean }>} oldest first */
+    this.calls = [];
+    /** @type {WeakMap<Promise<Uint8Array>, Object>} call behind each promise returned by call(), for remove() */
+    this.entries = new WeakMap();
+    /** @type {Object | null} queued call handed to the transport by drain() */
+    this.sending = null;
+    /** @type {Promise<void> | null} */
+    this.flushing = null;
+    this.loaded = this.load();
+  }
+
+  async load() {
+    let restored = [];
+    try {
+      const stored = this.storage ? await this.storage.getItem(this.key) : null;
+      restored = stored ? JSON.parse(stored) : [];
+    } catch (e) {
+      // an unreadable queue is dropped rather than blocking every call
+    }
+    const ignore = () => {};
+    this.calls.unshift(...restored.map((c) => ({ method: c.method, payload: base64ToBytes(c.payload), args: [], resolve: ignore, reject: ignore })));
+  }
+
+  async save() {
+    if (this.storage) {
+      await this.storage.setItem(this.key, JSON.stringify(this.calls.map((c) => ({ method: c.method, payload: bytesToBase64(c.payload) }))));
+    }
+  }
+
+  /**
+   * Sends a call, or queues it while the transport is offline or earlier calls are queued.
+   * @param {string} method
+   * @param {Uint8Array} payload
+   * @param {...*} args further arguments of the transport call, not persisted
+   * @returns {Promise<Uint8Array>} rejects without queueing the call when storage fails
+   */
+  call(method, payload, ...args) {
+    const ignore = () => {};
+    const call = { method, payload, args, resolve: ignore, reject: ignore, removed: false };
+    const response = this.enqueue(call);
+    this.entries.set(response, call);
+    return response;
+  }
+
+  async enqueue(call) {
+    await this.loaded;
+    if (call.removed) {
+      throw new Error(`${call.method} was removed from the offline queue`);
+    }
+    if (this.calls.length === 0 && this.isConnected()) {
+      return this.send(call.method, call.payload, ...call.args);
+    }
+    const response = new Promise((resolve, reject) => {
+      call.resolve = resolve;
+      call.reject = reject;
+    });
+    this.calls.push(call);
+    try {
+      await this.save();
+    } catch (e) {
+      const index = this.calls.indexOf(call);
+      if (index >= 0) {
+        this.calls.splice(index, 1);
+      }
+      throw e;
+    }
+    this.flush().catch(() => {});
+    return response;
+  }
+
+  /**
+   * Takes a call given up on, e.g. timed out or cancelled, out of the queue so
+   * it is not sent later; its promise rejects. A call already handed to the
+   * transport is left alone.
+   * @param {Promise<Uint8Array>} response promise returned by call()
+   * @returns {Promise<void>} rejects when storage fails
+   */
+  async remove(response) {
+    const call = this.entries.get(response);
+    if (!call || call === this.sending) {
+      return;
+    }
+    call.removed = true;
+    const index = this.calls.indexOf(call);
+    if (index < 0) {
+      return;
+    }
+    this.calls.splice(index, 1);
+    call.reject(new Error(`${call.method} was removed from the offline queue`));
+    await this.save();
+  }
+
+  /**
+   * Sends the queued calls while the transport is connected.
+   * @returns {Promise<void>} rejects when storage fails
+   */
+  flush() {
+    if (!this.flushing) {
+      this.flushing = this.drain().finally(() => {
+        this.flushing = null;
+      });
+    }
+    return this.flushing;
+  }
+
+  async drain() {
+    await this.loaded;
+    while (this.calls.length > 0 && this.isConnected()) {
+      const call = this.calls[0];
+      let response;
+      let error;
+      let failed = false;
+      this.sending = call;
+      try {
+        response = await this.send(call.method, call.payload, ...call.args);
+      } catch (e) {
+        failed = true;
+        error = e;
+      } finally {
+        this.sending = null;
+      }
+      if (failed && !this.isConnected()) {
+        return; // the transport dropped again: the call stays first in the queue
+      }
+      this.calls.shift();
+      await this.save();
+      if (failed) {
+        call.reject(error);
+      } else {
+        call.resolve(response);
+      }
+    }
+  }
+}
+
+/**
+ * @param {Uint8Array} bytes
+ * @returns {string}
+ */
+function bytesToBase64(bytes) {
+  let binary = "";
+  for (const b of bytes) {
+    binary += String.fromCharCode(b);
+  }
+  return btoa(binary);
+}
+
+/**
+ * @param {string} text
+ * @returns {Uint8Array}
+ */
+function base64ToBytes(text) {
+  return Uint8Array.from(atob(text), (c) => c.charCodeAt(0));
+}
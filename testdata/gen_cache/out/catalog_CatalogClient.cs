@@ -0,0 +1,76 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+using System.Collections.Generic;
+
+namespace Catalog
+{
+    public interface ICatalogClient
+    {
+        
+        UniTask<Item> GetItem(ItemQuery request);
+        
+        UniTask<Item> PutItem(Item request);
+        
+    }
+
+    public class CatalogClient : ICatalogClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "catalog.Catalog";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public CatalogClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        /// <summary>
+        /// Lifetime of cached responses of NO_SIDE_EFFECTS methods, in milliseconds.
+        /// </summary>
+        public const int CacheTtlMs = 30000;
+
+        private readonly Dictionary<string, (DateTime ExpiresAt, IMessage Response)> _responseCache = new Dictionary<string, (DateTime ExpiresAt, IMessage Response)>();
+
+        /// <summary>
+        /// Drops every cached response. Entries are only evicted by their TTL otherwise,
+        /// so call this after a mutation that may change the result of a cached method.
+        /// Cached responses are shared instances and must not be modified.
+        /// </summary>
+        public void ClearCache()
+        {
+            _responseCache.Clear();
+        }
+
+        
+        /// <summary>
+        /// Cached: reads only.
+        /// </summary>
+        public async UniTask<Item> GetItem(ItemQuery request)
+        {
+            var cacheKey = "Catalog.GetItem:" + request.ToByteString().ToBase64();
+            if (_responseCache.TryGetValue(cacheKey, out var cached) && cached.ExpiresAt > DateTime.UtcNow)
+            {
+                return (Item)cached.Response;
+            }
+            var response = await _rpcClient.CallMethod<Item>("Catalog.GetItem", request);
+            _responseCache[cacheKey] = (DateTime.UtcNow.AddMilliseconds(CacheTtlMs), response);
+            return response;
+        }
+        
+        /// <summary>
+        /// Not cached: changes the catalog.
+        /// </summary>
+        public async UniTask<Item> PutItem(Item request)
+        {
+            var response = await _rpcClient.CallMethod<Item>("Catalog.PutItem", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,75 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: CatalogClient
+
+// Import encoding/decoding functions for each method
+import { encodeItemQuery, decodeItem, encodeItem } from './Catalog.js';
+
+/**
+ * Fully-qualified proto name of Catalog, for routing and logging
+ */
+export const CatalogServiceName = "catalog.Catalog";
+
+export class CatalogClient {
+  /**
+   * Lifetime of cached responses of NO_SIDE_EFFECTS methods, in milliseconds.
+   */
+  static CACHE_TTL_MS = 30000;
+
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+    /** @type {Map<string, { expiresAt: number, response: Object }>} */
+    this.responseCache = new Map();
+  }
+
+  /**
+   * Drops every cached response. Entries are only evicted by their TTL otherwise,
+   * so call this after a mutation that may change the result of a cached method.
+   * Cached responses are shared objects and must not be modified.
+   */
+  clearCache() {
+    this.responseCache.clear();
+  }
+
+  
+  /**
+   * async GetItem
+   * Cached: reads only.
+   * @param { ItemQuery } requestObj
+   * @returns {Promise< Item >}
+   */
+  async GetItem(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeItemQuery(requestObj);
+    const cacheKey = "Catalog.GetItem:" + reqBytes.join(",");
+    const cached = this.responseCache.get(cacheKey);
+    if (cached && cached.expiresAt > Date.now()) {
+      return cached.response;
+    }
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Catalog.GetItem", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeItem(respBytes);
+    this.responseCache.set(cacheKey, { expiresAt: Date.now() + CatalogClient.CACHE_TTL_MS, response: respObj });
+    return respObj;
+  }
+  
+  /**
+   * async PutItem
+   * Not cached: changes the catalog.
+   * @param { Item } requestObj
+   * @returns {Promise< Item >}
+   */
+  async PutItem(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeItem(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Catalog.PutItem", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+}
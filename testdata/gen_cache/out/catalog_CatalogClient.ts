@@ -0,0 +1,99 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: CatalogClient
+
+// Import encoding/decoding functions for each method
+import { encodeItemQuery, decodeItem, encodeItem } from './Catalog';
+
+// Type definitions for request/response messages
+
+export interface ItemQuery {
+  [key: string]: any;
+}
+
+export interface Item {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Catalog, for routing and logging
+ */
+export const CatalogServiceName = "catalog.Catalog";
+
+/**
+ * Catalog RPC Client
+ * Provides type-safe methods to call Catalog on the server
+ */
+export class CatalogClient {
+  private rpcClient: WebViewRpcClient;
+
+  /**
+   * Lifetime of cached responses of NO_SIDE_EFFECTS methods, in milliseconds.
+   */
+  static readonly CACHE_TTL_MS = 30000;
+
+  private responseCache = new Map<string, { expiresAt: number; response: unknown }>();
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Drops every cached response. Entries are only evicted by their TTL otherwise,
+   * so call this after a mutation that may change the result of a cached method.
+   * Cached responses are shared objects and must not be modified.
+   */
+  clearCache(): void {
+    this.responseCache.clear();
+  }
+
+  
+  /**
+   * Call GetItem method
+   * Cached: reads only.
+   * @param requestObj - ItemQuery object
+   * @returns Promise resolving to Item
+   */
+  async GetItem(requestObj: ItemQuery): Promise<Item> {
+    // Encode request object to bytes
+    const reqBytes = encodeItemQuery(requestObj);
+    const cacheKey = "Catalog.GetItem:" + reqBytes.join(",");
+    const cached = this.responseCache.get(cacheKey);
+    if (cached && cached.expiresAt > Date.now()) {
+      return cached.response as Item;
+    }
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Catalog.GetItem", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeItem(respBytes);
+    this.responseCache.set(cacheKey, { expiresAt: Date.now() + CatalogClient.CACHE_TTL_MS, response: respObj });
+    return respObj;
+  }
+  
+  /**
+   * Call PutItem method
+   * Not cached: changes the catalog.
+   * @param requestObj - Item object
+   * @returns Promise resolving to Item
+   */
+  async PutItem(requestObj: Item): Promise<Item> {
+    // Encode request object to bytes
+    const reqBytes = encodeItem(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Catalog.PutItem", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+}
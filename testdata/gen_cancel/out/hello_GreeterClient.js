@@ -0,0 +1,82 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { newRequestId, encodeEnvelope, openEnvelope, RpcCancelledError } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+    /** @type {Map<string, { method: string, reject: (reason: Error) => void }>} */
+    this.pendingCalls = new Map();
+  }
+
+  /**
+   * Cancels an in-flight unary call: its promise rejects with RpcCancelledError and
+   * a "Greeter.$cancel" frame carrying the request id (UTF-8) tells the
+   * server to stop working on it. The response of the frame is ignored.
+   * @param {string} requestId - id passed to the call
+   * @returns {boolean} false when no call with this id is in flight
+   */
+  cancel(requestId) {
+    const pending = this.pendingCalls.get(requestId);
+    if (!pending) {
+      return false;
+    }
+    this.pendingCalls.delete(requestId);
+    pending.reject(new RpcCancelledError(pending.method, requestId));
+    this.rpcClient.callMethod("Greeter.$cancel", new TextEncoder().encode(requestId)).catch(() => {});
+    return true;
+  }
+
+  /**
+   * Settles like call unless cancel(requestId) rejects it first
+   * @template T
+   * @param {string} requestId
+   * @param {string} method
+   * @param {Promise<T>} call
+   * @returns {Promise<T>}
+   */
+  trackCall(requestId, method, call) {
+    if (this.pendingCalls.has(requestId)) {
+      return Promise.reject(new Error(`Request id ${requestId} is already in flight`));
+    }
+    return new Promise((resolve, reject) => {
+      this.pendingCalls.set(requestId, { method, reject });
+      call.then(resolve, reject).finally(() => this.pendingCalls.delete(requestId));
+    });
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @param {string} [requestId] id of the call for cancel(), a new one by default
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj, requestId = newRequestId()) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    let call = this.rpcClient.callMethod("Greeter.SayHello", encodeEnvelope("Greeter", "SayHello", requestId, reqBytes));
+    call = this.trackCall(requestId, "Greeter.SayHello", call);
+    const respBytes = await call;
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(openEnvelope(respBytes, "Greeter", "SayHello", requestId));
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,97 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+import { newRequestId, encodeEnvelope, openEnvelope, RpcCancelledError } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  private pendingCalls = new Map<string, { method: string; reject: (reason: Error) => void }>();
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Cancels an in-flight unary call: its promise rejects with RpcCancelledError and
+   * a "Greeter.$cancel" frame carrying the request id (UTF-8) tells the
+   * server to stop working on it. The response of the frame is ignored.
+   * @param requestId - id passed to the call
+   * @returns false when no call with this id is in flight
+   */
+  cancel(requestId: string): boolean {
+    const pending = this.pendingCalls.get(requestId);
+    if (!pending) {
+      return false;
+    }
+    this.pendingCalls.delete(requestId);
+    pending.reject(new RpcCancelledError(pending.method, requestId));
+    this.rpcClient.callMethod("Greeter.$cancel", new TextEncoder().encode(requestId)).catch(() => undefined);
+    return true;
+  }
+
+  /**
+   * Settles like call unless cancel(requestId) rejects it first
+   */
+  private trackCall<T>(requestId: string, method: string, call: Promise<T>): Promise<T> {
+    if (this.pendingCalls.has(requestId)) {
+      return Promise.reject(new Error(`Request id ${requestId} is already in flight`));
+    }
+    return new Promise<T>((resolve, reject) => {
+      this.pendingCalls.set(requestId, { method, reject });
+      call.then(resolve, reject).finally(() => this.pendingCalls.delete(requestId));
+    });
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @param requestId - id of the call for cancel(), a new one by default
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest, requestId: string = newRequestId()): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    let call = this.rpcClient.callMethod("Greeter.SayHello", encodeEnvelope("Greeter", "SayHello", requestId, reqBytes));
+    call = this.trackCall(requestId, "Greeter.SayHello", call);
+    const respBytes = await call;
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(openEnvelope(respBytes, "Greeter", "SayHello", requestId));
+    return respObj;
+  }
+  
+}
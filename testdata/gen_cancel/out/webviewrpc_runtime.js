@@ -0,0 +1,166 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Request or response wrapped by gen_envelope, naming the call it belongs to.
+ * @typedef {Object} RpcCallEnvelope
+ * @property {string} service
+ * @property {string} method
+ * @property {string} requestId pairs a response with its request
+ * @property {number} status 0 = ok, 1 = error (responses only)
+ * @property {Uint8Array} payload encoded request or response message, or UTF-8 error message
+ */
+
+/**
+ * Raised by openEnvelope for a response envelope with the error status: the
+ * server method failed with message.
+ */
+export class RpcCallError extends Error {
+  /**
+   * @param {string} service
+   * @param {string} method
+   * @param {string} requestId
+   * @param {string} message
+   */
+  constructor(service, method, requestId, message) {
+    super(`RPC call ${service}.${method} failed: ${message}`);
+    this.name = "RpcCallError";
+    this.service = service;
+    this.method = method;
+    this.requestId = requestId;
+  }
+}
+
+/**
+ * Creates the id pairing a request envelope with its response.
+ * @returns {string}
+ */
+export function newRequestId() {
+  if (typeof crypto !== "undefined" && crypto.randomUUID) {
+    return crypto.randomUUID();
+  }
+  return Date.now().toString(16) + Math.random().toString(16).slice(2);
+}
+
+/**
+ * Wraps a payload in the envelope of gen_envelope: service, method and request
+ * id (each uint32 length + UTF-8), a status byte, then the payload (uint32
+ * length + bytes), little-endian.
+ * @param {string} service
+ * @param {string} method
+ * @param {string} requestId
+ * @param {Uint8Array} payload
+ * @param {number} [status=0] 0 = ok, 1 = error with a UTF-8 message as payload
+ * @returns {Uint8Array}
+ */
+export function encodeEnvelope(service, method, requestId, payload, status = 0) {
+  const encoder = new TextEncoder();
+  const parts = [encoder.encode(service), encoder.encode(method), encoder.encode(requestId), payload];
+  const out = new Uint8Array(parts.reduce((size, part) => size + 4 + part.length, 1));
+  const view = new DataView(out.buffer);
+  let pos = 0;
+  parts.forEach((part, i) => {
+    if (i === 3) {
+      out[pos++] = status;
+    }
+    view.setUint32(pos, part.length, true);
+    out.set(part, pos + 4);
+    pos += 4 + part.length;
+  });
+  return out;
+}
+
+/**
+ * Response envelope reporting that the server method failed with message.
+ * @param {string} service
+ * @param {string} method
+ * @param {string} requestId
+ * @param {string} message
+ * @returns {Uint8Array}
+ */
+export function encodeErrorEnvelope(service, method, requestId, message) {
+  return encodeEnvelope(service, method, requestId, new TextEncoder().encode(message), 1);
+}
+
+/**
+ * Decodes an envelope, throwing when it was sent for another method.
+ * @param {Uint8Array} bytes
+ * @param {string} service
+ * @param {string} method
+ * @returns {RpcCallEnvelope}
+ */
+export function decodeEnvelope(bytes, service, method) {
+  const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+  const parts = [];
+  let status = 0;
+  let pos = 0;
+  for (let i = 0; i < 4; i++) {
+    if (i === 3) {
+      if (pos >= bytes.length) {
+        throw new Error("Truncated envelope");
+      }
+      status = bytes[pos++];
+    }
+    if (pos + 4 > bytes.length) {
+      throw new Error("Truncated envelope");
+    }
+    const length = view.getUint32(pos, true);
+    pos += 4;
+    if (pos + length > bytes.length) {
+      throw new Error("Truncated envelope");
+    }
+    parts.push(bytes.subarray(pos, pos + length));
+    pos += length;
+  }
+  const decoder = new TextDecoder();
+  const envelope = {
+    service: decoder.decode(parts[0]),
+    method: decoder.decode(parts[1]),
+    requestId: decoder.decode(parts[2]),
+    status,
+    payload: parts[3],
+  };
+  if (envelope.service !== service || envelope.method !== method) {
+    throw new Error(`Envelope of ${envelope.service}.${envelope.method} received by ${service}.${method}`);
+  }
+  return envelope;
+}
+
+/**
+ * Payload of a response envelope, throwing when it answers another request and
+ * RpcCallError when it carries the error status.
+ * @param {Uint8Array} bytes
+ * @param {string} service
+ * @param {string} method
+ * @param {string} requestId
+ * @returns {Uint8Array}
+ */
+export function openEnvelope(bytes, service, method, requestId) {
+  const envelope = decodeEnvelope(bytes, service, method);
+  if (envelope.requestId !== requestId) {
+    throw new Error(`Response to request ${envelope.requestId} received for request ${requestId}`);
+  }
+  if (envelope.status === 1) {
+    throw new RpcCallError(service, method, requestId, new TextDecoder().decode(envelope.payload));
+  }
+  if (envelope.status !== 0) {
+    throw new Error(`Unknown envelope status ${envelope.status}`);
+  }
+  return envelope.payload;
+}
+
+/**
+ * Raised when a call is cancelled by the client before its response arrives.
+ */
+export class RpcCancelledError extends Error {
+  /**
+   * @param {string} method
+   * @param {string} requestId
+   */
+  constructor(method, requestId) {
+    super(`RPC call ${method} (request ${requestId}) was cancelled`);
+    this.name = "RpcCancelledError";
+    this.method = method;
+    this.requestId = requestId;
+  }
+}
@@ -0,0 +1,86 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Calls callback when the WebSocket of the transport (rpcClient.socket) opens.
+   * Transports without a WebSocket, such as the WebView bridge, never call it.
+   * Listeners stay on the socket current at registration; register again after
+   * the transport replaces its socket on reconnect.
+   * @param {(event: Event) => void} callback
+   * @returns {() => void} function that removes the callback
+   */
+  onOpen(callback) {
+    return this.addConnectionListener("open", callback);
+  }
+
+  /**
+   * Calls callback when the WebSocket of the transport closes, whether closed
+   * by either side or dropped. Never called for transports without a WebSocket, see onOpen.
+   * @param {(event: CloseEvent) => void} callback
+   * @returns {() => void} function that removes the callback
+   */
+  onClose(callback) {
+    return this.addConnectionListener("close", callback);
+  }
+
+  /**
+   * Calls callback on a WebSocket error of the transport, typically followed
+   * by onClose. Never called for transports without a WebSocket, see onOpen.
+   * @param {(event: Event) => void} callback
+   * @returns {() => void} function that removes the callback
+   */
+  onError(callback) {
+    return this.addConnectionListener("error", callback);
+  }
+
+  /**
+   * @param {"open" | "close" | "error"} type
+   * @param {(event: Event) => void} callback
+   * @returns {() => void}
+   */
+  addConnectionListener(type, callback) {
+    const socket = this.rpcClient.socket;
+    if (!socket || typeof socket.addEventListener !== "function") {
+      return () => {};
+    }
+    socket.addEventListener(type, callback);
+    return () => socket.removeEventListener(type, callback);
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,100 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+using System.Collections.Generic;
+
+namespace Store
+{
+    public interface IStoreClient
+    {
+        
+        UniTask<Item> Find(Query request);
+        
+        UniTask<Item> Touch(Query request);
+        
+        UniTask<Item> Put(Item request);
+        
+    }
+
+    public class StoreClient : IStoreClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "store.Store";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public StoreClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        private readonly Dictionary<string, object> _inFlightCalls = new Dictionary<string, object>();
+
+        /// <summary>
+        /// Joins the call in flight under key, or starts one with send that later identical calls join until it completes.
+        /// </summary>
+        private async UniTask<T> Dedupe<T>(string key, Func<UniTask<T>> send)
+        {
+            if (_inFlightCalls.TryGetValue(key, out var inFlight))
+            {
+                return await (UniTask<T>)inFlight;
+            }
+            // a UniTask can only be awaited once unless preserved
+            var call = send().Preserve();
+            _inFlightCalls[key] = call;
+            try
+            {
+                return await call;
+            }
+            finally
+            {
+                _inFlightCalls.Remove(key);
+            }
+        }
+
+        
+        /// <summary>
+        /// Sends a Query and returns an Item.
+        /// </summary>
+        public async UniTask<Item> Find(Query request)
+        {
+            // gen_dedupe: identical requests made while this one is in flight share its response
+            return await Dedupe("Store.Find:" + request.ToByteString().ToBase64(), () => SendFind(request));
+        }
+
+        private async UniTask<Item> SendFind(Query request)
+        {
+            var response = await _rpcClient.CallMethod<Item>("Store.Find", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a Query and returns an Item.
+        /// </summary>
+        public async UniTask<Item> Touch(Query request)
+        {
+            // gen_dedupe: identical requests made while this one is in flight share its response
+            return await Dedupe("Store.Touch:" + request.ToByteString().ToBase64(), () => SendTouch(request));
+        }
+
+        private async UniTask<Item> SendTouch(Query request)
+        {
+            var response = await _rpcClient.CallMethod<Item>("Store.Touch", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends an Item and returns an Item.
+        /// </summary>
+        public async UniTask<Item> Put(Item request)
+        {
+            var response = await _rpcClient.CallMethod<Item>("Store.Put", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,108 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: StoreClient
+
+// Import encoding/decoding functions for each method
+import { encodeQuery, decodeItem, encodeItem } from './Store.js';
+
+/**
+ * Fully-qualified proto name of Store, for routing and logging
+ */
+export const StoreServiceName = "store.Store";
+
+export class StoreClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+    /** @type {Map<string, Promise<Object>>} */
+    this.inFlightCalls = new Map();
+  }
+
+  /**
+   * Joins the call in flight under key, or starts one with send that later
+   * identical calls join until it settles
+   * @template T
+   * @param {string} key method and encoded request
+   * @param {() => Promise<T>} send
+   * @returns {Promise<T>}
+   */
+  dedupe(key, send) {
+    let call = this.inFlightCalls.get(key);
+    if (!call) {
+      call = send().finally(() => this.inFlightCalls.delete(key));
+      this.inFlightCalls.set(key, call);
+    }
+    return call;
+  }
+
+  
+  /**
+   * async Find
+   * Sends a Query and returns an Item.
+   * @param { Query } requestObj
+   * @returns {Promise< Item >}
+   */
+  async Find(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeQuery(requestObj);
+    // gen_dedupe: identical requests made while this one is in flight share its response
+    return this.dedupe("Store.Find:" + reqBytes.join(","), () => this.sendFind(reqBytes));
+  }
+
+  /**
+   * Sends the encoded request of Find
+   * @param {Uint8Array} reqBytes
+   * @returns {Promise< Item >}
+   */
+  async sendFind(reqBytes) {
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Store.Find", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * async Touch
+   * Sends a Query and returns an Item.
+   * @param { Query } requestObj
+   * @returns {Promise< Item >}
+   */
+  async Touch(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeQuery(requestObj);
+    // gen_dedupe: identical requests made while this one is in flight share its response
+    return this.dedupe("Store.Touch:" + reqBytes.join(","), () => this.sendTouch(reqBytes));
+  }
+
+  /**
+   * Sends the encoded request of Touch
+   * @param {Uint8Array} reqBytes
+   * @returns {Promise< Item >}
+   */
+  async sendTouch(reqBytes) {
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Store.Touch", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * async Put
+   * Sends an Item and returns an Item.
+   * @param { Item } requestObj
+   * @returns {Promise< Item >}
+   */
+  async Put(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeItem(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Store.Put", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,126 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: StoreClient
+
+// Import encoding/decoding functions for each method
+import { encodeQuery, decodeItem, encodeItem } from './Store';
+
+// Type definitions for request/response messages
+
+export interface Query {
+  [key: string]: any;
+}
+
+export interface Item {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Store, for routing and logging
+ */
+export const StoreServiceName = "store.Store";
+
+/**
+ * Store RPC Client
+ * Provides type-safe methods to call Store on the server
+ */
+export class StoreClient {
+  private rpcClient: WebViewRpcClient;
+
+  private inFlightCalls = new Map<string, Promise<unknown>>();
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Joins the call in flight under key, or starts one with send that later
+   * identical calls join until it settles
+   */
+  private dedupe<T>(key: string, send: () => Promise<T>): Promise<T> {
+    let call = this.inFlightCalls.get(key) as Promise<T> | undefined;
+    if (!call) {
+      call = send().finally(() => this.inFlightCalls.delete(key));
+      this.inFlightCalls.set(key, call);
+    }
+    return call;
+  }
+
+  
+  /**
+   * Call Find method
+   * Sends a Query and returns an Item.
+   * @param requestObj - Query object
+   * @returns Promise resolving to Item
+   */
+  async Find(requestObj: Query): Promise<Item> {
+    // Encode request object to bytes
+    const reqBytes = encodeQuery(requestObj);
+    // gen_dedupe: identical requests made while this one is in flight share its response
+    return this.dedupe("Store.Find:" + reqBytes.join(","), () => this.sendFind(reqBytes));
+  }
+
+  /**
+   * Sends the encoded request of Find
+   */
+  private async sendFind(reqBytes: Uint8Array): Promise<Item> {
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Store.Find", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * Call Touch method
+   * Sends a Query and returns an Item.
+   * @param requestObj - Query object
+   * @returns Promise resolving to Item
+   */
+  async Touch(requestObj: Query): Promise<Item> {
+    // Encode request object to bytes
+    const reqBytes = encodeQuery(requestObj);
+    // gen_dedupe: identical requests made while this one is in flight share its response
+    return this.dedupe("Store.Touch:" + reqBytes.join(","), () => this.sendTouch(reqBytes));
+  }
+
+  /**
+   * Sends the encoded request of Touch
+   */
+  private async sendTouch(reqBytes: Uint8Array): Promise<Item> {
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Store.Touch", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * Call Put method
+   * Sends an Item and returns an Item.
+   * @param requestObj - Item object
+   * @returns Promise resolving to Item
+   */
+  async Put(requestObj: Item): Promise<Item> {
+    // Encode request object to bytes
+    const reqBytes = encodeItem(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Store.Put", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,20 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Every generated client of this run, created over one shared transport.
+    /// </summary>
+    public sealed class WebviewRpc
+    {
+        public WebViewRpcClient RpcClient { get; }
+        public global::Shop.ICartClient Cart { get; }
+        public global::Shop.IOrdersClient Orders { get; }
+
+        public WebviewRpc(WebViewRpcClient rpcClient)
+        {
+            RpcClient = rpcClient;
+            Cart = new global::Shop.CartClient(rpcClient);
+            Orders = new global::Shop.OrdersClient(rpcClient);
+        }
+    }
+}
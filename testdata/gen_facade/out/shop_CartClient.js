@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: CartClient
+
+// Import encoding/decoding functions for each method
+import { encodeItem, decodeItem } from './Cart.js';
+
+/**
+ * Fully-qualified proto name of Cart, for routing and logging
+ */
+export const CartServiceName = "shop.Cart";
+
+export class CartClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Add
+   * Sends an Item and returns an Item.
+   * @param { Item } requestObj
+   * @returns {Promise< Item >}
+   */
+  async Add(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeItem(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Cart.Add", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+}
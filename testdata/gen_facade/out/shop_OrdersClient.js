@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: OrdersClient
+
+// Import encoding/decoding functions for each method
+import { encodeItem, decodeItem } from './Orders.js';
+
+/**
+ * Fully-qualified proto name of Orders, for routing and logging
+ */
+export const OrdersServiceName = "shop.Orders";
+
+export class OrdersClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Place
+   * Sends an Item and returns an Item.
+   * @param { Item } requestObj
+   * @returns {Promise< Item >}
+   */
+  async Place(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeItem(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Orders.Place", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: OrdersClient
+
+// Import encoding/decoding functions for each method
+import { encodeItem, decodeItem } from './Orders';
+
+// Type definitions for request/response messages
+
+export interface Item {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Orders, for routing and logging
+ */
+export const OrdersServiceName = "shop.Orders";
+
+/**
+ * Orders RPC Client
+ * Provides type-safe methods to call Orders on the server
+ */
+export class OrdersClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Place method
+   * Sends an Item and returns an Item.
+   * @param requestObj - Item object
+   * @returns Promise resolving to Item
+   */
+  async Place(requestObj: Item): Promise<Item> {
+    // Encode request object to bytes
+    const reqBytes = encodeItem(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Orders.Place", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeItem(respBytes);
+    return respObj;
+  }
+  
+}
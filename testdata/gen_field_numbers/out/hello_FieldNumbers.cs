@@ -0,0 +1,24 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Field numbers of the messages of hello.proto
+
+namespace Helloworld
+{
+    public static class HelloRequestFieldNumbers
+    {
+        public const int Name = 1;
+        public const int Id = 7;
+        public const int Tags = 12;
+    }
+
+    public static class HelloReplyFieldNumbers
+    {
+        public const int Message = 1;
+        public const int Detail = 3;
+    }
+
+    public static class HelloReply_DetailFieldNumbers
+    {
+        public const int Note = 1;
+        public const int Labels = 2;
+    }
+}
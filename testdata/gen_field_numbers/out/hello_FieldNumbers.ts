@@ -0,0 +1,18 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Field numbers of the messages of hello.proto
+
+export const HelloRequestFieldNumbers = Object.freeze({
+  name: 1,
+  id: 7,
+  tags: 12,
+});
+
+export const HelloReplyFieldNumbers = Object.freeze({
+  message: 1,
+  detail: 3,
+});
+
+export const HelloReply_DetailFieldNumbers = Object.freeze({
+  note: 1,
+  labels: 2,
+});
@@ -0,0 +1,57 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support types shared by the generated clients and servers
+using System;
+using System.Collections.Generic;
+using Cysharp.Threading.Tasks;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Unary call of a client as seen by its interceptors, which may replace the
+    /// request before passing it on.
+    /// </summary>
+    public sealed class RpcInvocation
+    {
+        /// <summary>
+        /// Full name of the method, e.g. "Greeter.SayHello".
+        /// </summary>
+        public string Method { get; }
+        public object Request { get; set; }
+
+        public RpcInvocation(string method, object request)
+        {
+            Method = method;
+            Request = request;
+        }
+    }
+
+    /// <summary>
+    /// Wraps the unary calls of the clients it is added to with AddInterceptor: it
+    /// may change the call before passing it to next, and observe or replace what
+    /// next returns, the result of the client method.
+    /// </summary>
+    public interface IRpcInterceptor
+    {
+        UniTask<object> Intercept(RpcInvocation call, Func<RpcInvocation, UniTask<object>> next);
+    }
+
+    public static class RpcInterceptors
+    {
+        /// <summary>
+        /// Runs call through interceptors, the first one outermost, and then send.
+        /// </summary>
+        public static UniTask<object> Run(IReadOnlyList<IRpcInterceptor> interceptors, RpcInvocation call, Func<RpcInvocation, UniTask<object>> send)
+        {
+            return Next(0)(call);
+
+            Func<RpcInvocation, UniTask<object>> Next(int index)
+            {
+                if (index == interceptors.Count)
+                {
+                    return send;
+                }
+                return c => interceptors[index].Intercept(c, Next(index + 1));
+            }
+        }
+    }
+}
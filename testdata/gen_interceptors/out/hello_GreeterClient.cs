@@ -0,0 +1,68 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+using System.Collections.Generic;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+        private readonly List<IRpcInterceptor> _interceptors = new List<IRpcInterceptor>();
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        /// <summary>
+        /// Adds interceptor around the unary calls of the client. Interceptors run in the
+        /// order they were added: the first one sees each call first and its result last.
+        /// </summary>
+        public GreeterClient AddInterceptor(IRpcInterceptor interceptor)
+        {
+            _interceptors.Add(interceptor);
+            return this;
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            // gen_interceptors: the interceptors may replace the request
+            return (HelloReply)await RpcInterceptors.Run(
+                _interceptors,
+                new RpcInvocation("Greeter.SayHello", request),
+                async call => await InvokeSayHello((HelloRequest)call.Request));
+        }
+
+        /// <summary>
+        /// SayHello once the interceptors passed it on.
+        /// </summary>
+        private async UniTask<HelloReply> InvokeSayHello(HelloRequest request)
+        {
+            var response = await _rpcClient.CallMethod<HelloReply>("Greeter.SayHello", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,68 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { runInterceptors } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+    /** @type {Array<import('./webviewrpc_runtime.js').RpcInterceptor>} outermost first */
+    this.interceptors = [];
+  }
+
+  /**
+   * Adds interceptor around the unary calls of the client. Interceptors run in the
+   * order they were added: the first one sees each call first and its result last.
+   * @param {import('./webviewrpc_runtime.js').RpcInterceptor} interceptor
+   * @returns {this}
+   */
+  addInterceptor(interceptor) {
+    this.interceptors.push(interceptor);
+    return this;
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // gen_interceptors: the interceptors may replace the request
+    return runInterceptors(
+      this.interceptors,
+      { method: "Greeter.SayHello", request: requestObj },
+      (call) => this.invokeSayHello(call.request)
+    );
+  }
+
+  /**
+   * SayHello once the interceptors passed it on
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async invokeSayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
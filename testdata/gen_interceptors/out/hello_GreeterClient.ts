@@ -0,0 +1,85 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+import { RpcInterceptor, runInterceptors } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  /** outermost first */
+  private interceptors: RpcInterceptor[] = [];
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Adds interceptor around the unary calls of the client. Interceptors run in the
+   * order they were added: the first one sees each call first and its result last.
+   */
+  addInterceptor(interceptor: RpcInterceptor): this {
+    this.interceptors.push(interceptor);
+    return this;
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // gen_interceptors: the interceptors may replace the request
+    return runInterceptors(
+      this.interceptors,
+      { method: "Greeter.SayHello", request: requestObj },
+      (call) => this.invokeSayHello(call.request as HelloRequest)
+    ) as Promise<HelloReply>;
+  }
+
+  /**
+   * SayHello once the interceptors passed it on
+   */
+  private async invokeSayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,32 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Unary call of a client as seen by its interceptors, which may replace the
+ * request before passing it on.
+ * @typedef {Object} RpcInvocation
+ * @property {string} method full name of the method, e.g. "Greeter.SayHello"
+ * @property {Object} request
+ */
+
+/**
+ * Wraps the unary calls of the clients it is added to with addInterceptor: it
+ * may change the call before passing it to next, and observe or replace what
+ * next resolves to, the result of the client method.
+ * @callback RpcInterceptor
+ * @param {RpcInvocation} call
+ * @param {function(RpcInvocation): Promise<Object>} next
+ * @returns {Promise<Object>}
+ */
+
+/**
+ * Runs call through interceptors, the first one outermost, and then send.
+ * @param {RpcInterceptor[]} interceptors
+ * @param {RpcInvocation} call
+ * @param {function(RpcInvocation): Promise<Object>} send
+ * @returns {Promise<Object>}
+ */
+export function runInterceptors(interceptors, call, send) {
+  const next = (index) => (c) => (index === interceptors.length ? send(c) : interceptors[index](c, next(index + 1)));
+  return next(0)(call);
+}
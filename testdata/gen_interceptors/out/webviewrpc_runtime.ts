@@ -0,0 +1,27 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Unary call of a client as seen by its interceptors, which may replace the
+ * request before passing it on
+ */
+export interface RpcInvocation {
+  /** full name of the method, e.g. "Greeter.SayHello" */
+  readonly method: string;
+  request: unknown;
+}
+
+/**
+ * Wraps the unary calls of the clients it is added to with addInterceptor: it
+ * may change the call before passing it to next, and observe or replace what
+ * next resolves to, the result of the client method
+ */
+export type RpcInterceptor = (call: RpcInvocation, next: (call: RpcInvocation) => Promise<unknown>) => Promise<unknown>;
+
+/**
+ * Runs call through interceptors, the first one outermost, and then send
+ */
+export function runInterceptors(interceptors: readonly RpcInterceptor[], call: RpcInvocation, send: (call: RpcInvocation) => Promise<unknown>): Promise<unknown> {
+  const next = (index: number) => (c: RpcInvocation): Promise<unknown> => (index === interceptors.length ? send(c) : interceptors[index](c, next(index + 1)));
+  return next(0)(call);
+}
@@ -0,0 +1,129 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support types shared by the generated clients and servers
+using System;
+using System.Collections;
+using System.Collections.Generic;
+using System.Diagnostics;
+using Google.Protobuf;
+using Google.Protobuf.Reflection;
+using Cysharp.Threading.Tasks;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Unary call of a client generated with gen_log_hook, as passed to its log hook. Request
+    /// and Response are copies with the fields marked (webviewrpc.sensitive) cleared.
+    /// </summary>
+    public sealed class RpcLogEntry
+    {
+        /// <summary>
+        /// Name of the method, e.g. "Greeter.SayHello".
+        /// </summary>
+        public string Method { get; set; }
+        public IMessage Request { get; set; }
+
+        /// <summary>
+        /// Response of the call, null when it failed.
+        /// </summary>
+        public IMessage Response { get; set; }
+
+        /// <summary>
+        /// Exception the call failed with, null when it succeeded.
+        /// </summary>
+        public Exception Error { get; set; }
+        public double DurationMs { get; set; }
+    }
+
+    /// <summary>
+    /// Log hook the clients generated with gen_log_hook pass each unary call to once it
+    /// completed, passed to their constructor.
+    /// </summary>
+    public interface IRpcLogHook
+    {
+        void Log(RpcLogEntry entry);
+    }
+
+    public static class RpcLogging
+    {
+        /// <summary>
+        /// Runs call and passes it to hook once it completed, with the request and the
+        /// response picked from its result redacted. Without a hook call just runs.
+        /// </summary>
+        public static async UniTask<T> Call<T>(IRpcLogHook hook, ISet<string> sensitiveFields, string method, IMessage request, Func<UniTask<T>> call, Func<T, IMessage> response)
+        {
+            if (hook == null)
+            {
+                return await call();
+            }
+            // redacted before the call, which may change the request
+            var entry = new RpcLogEntry { Method = method, Request = Redact(request, sensitiveFields) };
+            var started = Stopwatch.GetTimestamp();
+            try
+            {
+                var result = await call();
+                entry.Response = Redact(response(result), sensitiveFields);
+                return result;
+            }
+            catch (Exception e)
+            {
+                entry.Error = e;
+                throw;
+            }
+            finally
+            {
+                entry.DurationMs = (Stopwatch.GetTimestamp() - started) * 1000.0 / Stopwatch.Frequency;
+                hook.Log(entry);
+            }
+        }
+
+        /// <summary>
+        /// Copy of message with the fields of sensitiveFields cleared, in the messages it
+        /// holds too. sensitiveFields are full proto names, e.g. "helloworld.User.email".
+        /// </summary>
+        public static IMessage Redact(IMessage message, ISet<string> sensitiveFields)
+        {
+            if (message == null || sensitiveFields.Count == 0)
+            {
+                return message;
+            }
+            var copy = message.Descriptor.Parser.ParseFrom(message.ToByteString());
+            Clear(copy, sensitiveFields);
+            return copy;
+        }
+
+        private static void Clear(IMessage message, ISet<string> sensitiveFields)
+        {
+            foreach (var field in message.Descriptor.Fields.InFieldNumberOrder())
+            {
+                if (sensitiveFields.Contains(field.FullName))
+                {
+                    field.Accessor.Clear(message);
+                }
+                else if (field.IsMap)
+                {
+                    if (field.MessageType.FindFieldByNumber(2).FieldType == FieldType.Message)
+                    {
+                        foreach (IMessage value in ((IDictionary)field.Accessor.GetValue(message)).Values)
+                        {
+                            Clear(value, sensitiveFields);
+                        }
+                    }
+                }
+                else if (field.FieldType == FieldType.Message || field.FieldType == FieldType.Group)
+                {
+                    if (field.IsRepeated)
+                    {
+                        foreach (IMessage item in (IList)field.Accessor.GetValue(message))
+                        {
+                            Clear(item, sensitiveFields);
+                        }
+                    }
+                    else if (field.Accessor.GetValue(message) is IMessage nested)
+                    {
+                        Clear(nested, sensitiveFields);
+                    }
+                }
+            }
+        }
+    }
+}
@@ -0,0 +1,81 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System.Collections.Generic;
+
+namespace Users
+{
+    public interface IUsersClient
+    {
+        
+        UniTask<User> GetUser(GetUserRequest request);
+        
+        UniTask<PingReply> Ping(PingRequest request);
+        
+    }
+
+    public class UsersClient : IUsersClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "users.Users";
+
+        private readonly WebViewRpcClient _rpcClient;
+        private readonly IRpcLogHook _logHook;
+
+        // (webviewrpc.sensitive) fields of the requests and responses, cleared in what the log hook gets
+        private static readonly HashSet<string> SensitiveFields = new HashSet<string>
+        {
+            "users.GetUserRequest.session_token",
+            "users.User.email",
+            "users.Address.street",
+        };
+
+        /// <param name="logHook">Gets each unary call once it completed, with its sensitive fields cleared; calls are not logged when null.</param>
+        public UsersClient(WebViewRpcClient rpcClient, IRpcLogHook logHook = null)
+        {
+            this._rpcClient = rpcClient;
+            this._logHook = logHook;
+        }
+
+        
+        /// <summary>
+        /// Sends a GetUserRequest and returns an User.
+        /// </summary>
+        public async UniTask<User> GetUser(GetUserRequest request)
+        {
+            // gen_log_hook: the call is passed to the log hook once it completed
+            return await RpcLogging.Call(_logHook, SensitiveFields, "Users.GetUser", request, () => LoggedGetUser(request), result => result);
+        }
+
+        /// <summary>
+        /// GetUser as passed to the log hook.
+        /// </summary>
+        private async UniTask<User> LoggedGetUser(GetUserRequest request)
+        {
+            var response = await _rpcClient.CallMethod<User>("Users.GetUser", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a PingRequest and returns a PingReply.
+        /// </summary>
+        public async UniTask<PingReply> Ping(PingRequest request)
+        {
+            // gen_log_hook: the call is passed to the log hook once it completed
+            return await RpcLogging.Call(_logHook, SensitiveFields, "Users.Ping", request, () => LoggedPing(request), result => result);
+        }
+
+        /// <summary>
+        /// Ping as passed to the log hook.
+        /// </summary>
+        private async UniTask<PingReply> LoggedPing(PingRequest request)
+        {
+            var response = await _rpcClient.CallMethod<PingReply>("Users.Ping", request);
+            return response;
+        }
+        
+    }
+}
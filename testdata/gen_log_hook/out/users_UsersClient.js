@@ -0,0 +1,101 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: UsersClient
+
+// Import encoding/decoding functions for each method
+import { encodeGetUserRequest, decodeUser, encodePingRequest, decodePingReply } from './Users.js';
+import { withLogHook } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Users, for routing and logging
+ */
+export const UsersServiceName = "users.Users";
+
+export class UsersClient {
+  /**
+   * (webviewrpc.sensitive) fields of the requests and responses, redacted in what the log hook gets.
+   * @type {import('./webviewrpc_runtime.js').RpcSensitiveFields}
+   */
+  static SENSITIVE_FIELDS = Object.freeze({
+    "users.GetUserRequest": {
+      redact: ["sessionToken"],
+    },
+    "users.User": {
+      redact: ["email"],
+      messages: { "home": "users.Address", "previous": "users.Address", "referral": "users.Referral" },
+      maps: { "byLabel": "users.Address" },
+    },
+    "users.Address": {
+      redact: ["street"],
+    },
+    "users.Referral": {
+      messages: { "referrer": "users.User" },
+    },
+  });
+
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   * @param {import('./webviewrpc_runtime.js').RpcLogHook} [logHook] gets each unary call once it settled, with its sensitive fields redacted; calls are not logged without one
+   */
+  constructor(rpcClient, logHook = undefined) {
+    this.rpcClient = rpcClient;
+    this.logHook = logHook;
+  }
+
+  
+  /**
+   * async GetUser
+   * Sends a GetUserRequest and returns an User.
+   * @param { GetUserRequest } requestObj
+   * @returns {Promise< User >}
+   */
+  async GetUser(requestObj) {
+    // gen_log_hook: the call is passed to the log hook once it settled
+    return withLogHook(this.logHook, UsersClient.SENSITIVE_FIELDS, "Users.GetUser", "users.GetUserRequest", "users.User", requestObj, () =>
+      this.loggedGetUser(requestObj)
+    );
+  }
+
+  /**
+   * GetUser as passed to the log hook
+   * @param { GetUserRequest } requestObj
+   * @returns {Promise< User >}
+   */
+  async loggedGetUser(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeGetUserRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Users.GetUser", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeUser(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * async Ping
+   * Sends a PingRequest and returns a PingReply.
+   * @param { PingRequest } requestObj
+   * @returns {Promise< PingReply >}
+   */
+  async Ping(requestObj) {
+    // gen_log_hook: the call is passed to the log hook once it settled
+    return withLogHook(this.logHook, UsersClient.SENSITIVE_FIELDS, "Users.Ping", "users.PingRequest", "users.PingReply", requestObj, () =>
+      this.loggedPing(requestObj)
+    );
+  }
+
+  /**
+   * Ping as passed to the log hook
+   * @param { PingRequest } requestObj
+   * @returns {Promise< PingReply >}
+   */
+  async loggedPing(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodePingRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Users.Ping", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodePingReply(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,133 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: UsersClient
+
+// Import encoding/decoding functions for each method
+import { encodeGetUserRequest, decodeUser, encodePingRequest, decodePingReply } from './Users';
+import { RpcLogHook, RpcSensitiveFields, withLogHook } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface GetUserRequest {
+  [key: string]: any;
+}
+
+export interface User {
+  [key: string]: any;
+}
+
+export interface PingRequest {
+  [key: string]: any;
+}
+
+export interface PingReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Users, for routing and logging
+ */
+export const UsersServiceName = "users.Users";
+
+/**
+ * Users RPC Client
+ * Provides type-safe methods to call Users on the server
+ */
+export class UsersClient {
+  private rpcClient: WebViewRpcClient;
+  /** gets each unary call once it settled, unlogged when undefined */
+  private logHook: RpcLogHook | undefined;
+
+  /**
+   * (webviewrpc.sensitive) fields of the requests and responses, redacted in what the log hook gets.
+   */
+  static readonly SENSITIVE_FIELDS: RpcSensitiveFields = Object.freeze({
+    "users.GetUserRequest": {
+      redact: ["sessionToken"],
+    },
+    "users.User": {
+      redact: ["email"],
+      messages: { "home": "users.Address", "previous": "users.Address", "referral": "users.Referral" },
+      maps: { "byLabel": "users.Address" },
+    },
+    "users.Address": {
+      redact: ["street"],
+    },
+    "users.Referral": {
+      messages: { "referrer": "users.User" },
+    },
+  });
+
+  /**
+   * @param rpcClient - transport of the calls
+   * @param logHook - gets each unary call once it settled, with its sensitive fields redacted; calls are not logged without one
+   */
+  constructor(rpcClient: WebViewRpcClient, logHook?: RpcLogHook) {
+    this.rpcClient = rpcClient;
+    this.logHook = logHook;
+  }
+
+  
+  /**
+   * Call GetUser method
+   * Sends a GetUserRequest and returns an User.
+   * @param requestObj - GetUserRequest object
+   * @returns Promise resolving to User
+   */
+  async GetUser(requestObj: GetUserRequest): Promise<User> {
+    // gen_log_hook: the call is passed to the log hook once it settled
+    return withLogHook(this.logHook, UsersClient.SENSITIVE_FIELDS, "Users.GetUser", "users.GetUserRequest", "users.User", requestObj, () =>
+      this.loggedGetUser(requestObj)
+    );
+  }
+
+  /**
+   * GetUser as passed to the log hook
+   */
+  private async loggedGetUser(requestObj: GetUserRequest): Promise<User> {
+    // Encode request object to bytes
+    const reqBytes = encodeGetUserRequest(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Users.GetUser", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeUser(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * Call Ping method
+   * Sends a PingRequest and returns a PingReply.
+   * @param requestObj - PingRequest object
+   * @returns Promise resolving to PingReply
+   */
+  async Ping(requestObj: PingRequest): Promise<PingReply> {
+    // gen_log_hook: the call is passed to the log hook once it settled
+    return withLogHook(this.logHook, UsersClient.SENSITIVE_FIELDS, "Users.Ping", "users.PingRequest", "users.PingReply", requestObj, () =>
+      this.loggedPing(requestObj)
+    );
+  }
+
+  /**
+   * Ping as passed to the log hook
+   */
+  private async loggedPing(requestObj: PingRequest): Promise<PingReply> {
+    // Encode request object to bytes
+    const reqBytes = encodePingRequest(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Users.Ping", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodePingReply(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,97 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Unary call of a client generated with gen_log_hook, as passed to its log
+ * hook. request and response are copies whose fields marked
+ * (webviewrpc.sensitive) hold "[REDACTED]".
+ * @typedef {Object} RpcLogEntry
+ * @property {string} method name of the method, e.g. "Greeter.SayHello"
+ * @property {Object} request
+ * @property {Object} [response] set when the call succeeded
+ * @property {*} [error] set when the call failed
+ * @property {number} durationMs
+ */
+
+/**
+ * Log hook the clients generated with gen_log_hook pass each unary call to
+ * once it settled, passed to their constructor.
+ * @typedef {function(RpcLogEntry): void} RpcLogHook
+ */
+
+/**
+ * Sensitive fields of the messages of a client by full proto name: the JSON
+ * names of the fields to redact, and of the message and map fields holding
+ * messages with such fields, with their type.
+ * @typedef {Object<string, { redact?: string[], messages?: Object<string, string>, maps?: Object<string, string> }>} RpcSensitiveFields
+ */
+
+/**
+ * Runs call and passes it to hook once it settled, with its request of type
+ * requestType and response of type responseType redacted. Without a hook call
+ * just runs.
+ * @template T
+ * @param {RpcLogHook | undefined} hook
+ * @param {RpcSensitiveFields} sensitive
+ * @param {string} method
+ * @param {string} requestType
+ * @param {string} responseType
+ * @param {Object} request
+ * @param {function(): Promise<T>} call
+ * @returns {Promise<T>}
+ */
+export async function withLogHook(hook, sensitive, method, requestType, responseType, request, call) {
+  if (!hook) {
+    return call();
+  }
+  // redacted before the call, which may change the request
+  const entry = { method, request: redactMessage(request, requestType, sensitive), durationMs: 0 };
+  const started = performance.now();
+  try {
+    const result = await call();
+    entry.response = redactMessage(result, responseType, sensitive);
+    return result;
+  } catch (e) {
+    entry.error = e;
+    throw e;
+  } finally {
+    entry.durationMs = performance.now() - started;
+    hook(entry);
+  }
+}
+
+/**
+ * Copy of message, of the type named type, with its sensitive fields set to
+ * "[REDACTED]", in the messages it holds too
+ * @param {Object} message
+ * @param {string} type
+ * @param {RpcSensitiveFields} sensitive
+ * @returns {Object}
+ */
+export function redactMessage(message, type, sensitive) {
+  const fields = sensitive[type];
+  if (!fields || message === null || typeof message !== "object") {
+    return message;
+  }
+  const copy = { ...message };
+  for (const name of fields.redact ?? []) {
+    if (copy[name] !== undefined && copy[name] !== null) {
+      copy[name] = "[REDACTED]";
+    }
+  }
+  for (const [name, fieldType] of Object.entries(fields.messages ?? {})) {
+    const value = copy[name];
+    if (Array.isArray(value)) {
+      copy[name] = value.map((item) => redactMessage(item, fieldType, sensitive));
+    } else if (value !== undefined && value !== null) {
+      copy[name] = redactMessage(value, fieldType, sensitive);
+    }
+  }
+  for (const [name, fieldType] of Object.entries(fields.maps ?? {})) {
+    const value = copy[name];
+    if (value !== undefined && value !== null) {
+      copy[name] = Object.fromEntries(Object.entries(value).map(([key, item]) => [key, redactMessage(item, fieldType, sensitive)]));
+    }
+  }
+  return copy;
+}
@@ -0,0 +1,88 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Unary call of a client generated with gen_log_hook, as passed to its log
+ * hook. request and response are copies whose fields marked
+ * (webviewrpc.sensitive) hold "[REDACTED]"
+ */
+export interface RpcLogEntry {
+  /** name of the method, e.g. "Greeter.SayHello" */
+  method: string;
+  request: unknown;
+  /** set when the call succeeded */
+  response?: unknown;
+  /** set when the call failed */
+  error?: unknown;
+  durationMs: number;
+}
+
+/**
+ * Log hook the clients generated with gen_log_hook pass each unary call to
+ * once it settled, passed to their constructor
+ */
+export type RpcLogHook = (entry: RpcLogEntry) => void;
+
+/**
+ * Sensitive fields of the messages of a client by full proto name: the JSON
+ * names of the fields to redact, and of the message and map fields holding
+ * messages with such fields, with their type
+ */
+export type RpcSensitiveFields = Readonly<Record<string, { redact?: readonly string[]; messages?: Readonly<Record<string, string>>; maps?: Readonly<Record<string, string>> }>>;
+
+/**
+ * Runs call and passes it to hook once it settled, with its request of type
+ * requestType and response of type responseType redacted. Without a hook call
+ * just runs
+ */
+export async function withLogHook<T>(hook: RpcLogHook | undefined, sensitive: RpcSensitiveFields, method: string, requestType: string, responseType: string, request: unknown, call: () => Promise<T>): Promise<T> {
+  if (!hook) {
+    return call();
+  }
+  // redacted before the call, which may change the request
+  const entry: RpcLogEntry = { method, request: redactMessage(request, requestType, sensitive), durationMs: 0 };
+  const started = performance.now();
+  try {
+    const result = await call();
+    entry.response = redactMessage(result, responseType, sensitive);
+    return result;
+  } catch (e) {
+    entry.error = e;
+    throw e;
+  } finally {
+    entry.durationMs = performance.now() - started;
+    hook(entry);
+  }
+}
+
+/**
+ * Copy of message, of the type named type, with its sensitive fields set to
+ * "[REDACTED]", in the messages it holds too
+ */
+export function redactMessage(message: unknown, type: string, sensitive: RpcSensitiveFields): unknown {
+  const fields = sensitive[type];
+  if (!fields || message === null || typeof message !== "object") {
+    return message;
+  }
+  const copy: Record<string, unknown> = { ...(message as Record<string, unknown>) };
+  for (const name of fields.redact ?? []) {
+    if (copy[name] !== undefined && copy[name] !== null) {
+      copy[name] = "[REDACTED]";
+    }
+  }
+  for (const [name, fieldType] of Object.entries(fields.messages ?? {})) {
+    const value = copy[name];
+    if (Array.isArray(value)) {
+      copy[name] = value.map((item) => redactMessage(item, fieldType, sensitive));
+    } else if (value !== undefined && value !== null) {
+      copy[name] = redactMessage(value, fieldType, sensitive);
+    }
+  }
+  for (const [name, fieldType] of Object.entries(fields.maps ?? {})) {
+    const value = copy[name];
+    if (value !== undefined && value !== null) {
+      copy[name] = Object.fromEntries(Object.entries(value as Record<string, unknown>).map(([key, item]) => [key, redactMessage(item, fieldType, sensitive)]));
+    }
+  }
+  return copy;
+}
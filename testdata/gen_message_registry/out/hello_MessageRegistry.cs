@@ -0,0 +1,29 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Message factories of hello.proto by full proto name
+using System;
+using System.Collections.Generic;
+using Google.Protobuf;
+
+namespace Helloworld
+{
+    /// <summary>
+    /// Creates the messages of hello.proto from their full proto name, e.g. to parse a payload whose type is only known at runtime.
+    /// </summary>
+    public static class HelloMessageRegistry
+    {
+        public static readonly IReadOnlyDictionary<string, Func<IMessage>> Factories = new Dictionary<string, Func<IMessage>>
+        {
+            ["helloworld.HelloRequest"] = () => new HelloRequest(),
+            ["helloworld.HelloReply"] = () => new HelloReply(),
+            ["helloworld.HelloReply.Detail"] = () => new HelloReply.Types.Detail(),
+        };
+
+        /// <summary>
+        /// A new empty message of the type named fullName, null when the proto declares no such message.
+        /// </summary>
+        public static IMessage Create(string fullName)
+        {
+            return Factories.TryGetValue(fullName, out var factory) ? factory() : null;
+        }
+    }
+}
@@ -0,0 +1,28 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Message factories of hello.proto by full proto name
+
+/**
+ * Functions creating a message object of hello.proto with its default field values, by full proto name
+ */
+export const HelloMessageFactories = Object.freeze({
+  "helloworld.HelloRequest": () => ({
+    name: "",
+  }),
+  "helloworld.HelloReply": () => ({
+    message: "",
+  }),
+  "helloworld.HelloReply.Detail": () => ({
+    note: "",
+    code: 0,
+  }),
+});
+
+/**
+ * A new message object of the type named fullName, undefined when the proto declares no such message
+ * @param {string} fullName e.g. "helloworld.HelloRequest"
+ * @returns {Object | undefined}
+ */
+export function createHelloMessage(fullName) {
+  const factory = Object.prototype.hasOwnProperty.call(HelloMessageFactories, fullName) ? HelloMessageFactories[fullName] : undefined;
+  return factory ? factory() : undefined;
+}
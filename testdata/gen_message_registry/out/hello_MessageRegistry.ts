@@ -0,0 +1,26 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Message factories of hello.proto by full proto name
+
+/**
+ * Functions creating a message object of hello.proto with its default field values, by full proto name
+ */
+export const HelloMessageFactories: Readonly<Record<string, () => Record<string, unknown>>> = Object.freeze({
+  "helloworld.HelloRequest": () => ({
+    name: "",
+  }),
+  "helloworld.HelloReply": () => ({
+    message: "",
+  }),
+  "helloworld.HelloReply.Detail": () => ({
+    note: "",
+    code: 0,
+  }),
+});
+
+/**
+ * A new message object of the type named fullName (e.g. "helloworld.HelloRequest"), undefined when the proto declares no such message
+ */
+export function createHelloMessage(fullName: string): Record<string, unknown> | undefined {
+  const factory = Object.prototype.hasOwnProperty.call(HelloMessageFactories, fullName) ? HelloMessageFactories[fullName] : undefined;
+  return factory ? factory() : undefined;
+}
@@ -0,0 +1,28 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support types shared by the generated clients and servers
+using System;
+using System.Collections.Generic;
+using Cysharp.Threading.Tasks;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Per-call metadata (auth tokens, locale, ...) sent alongside the request message.
+    /// </summary>
+    public sealed class RpcMetadata : Dictionary<string, string>
+    {
+    }
+
+    /// <summary>
+    /// Context of a call received by a generated server base.
+    /// </summary>
+    public sealed class RpcCallContext
+    {
+        public RpcMetadata Metadata { get; }
+
+        public RpcCallContext(RpcMetadata metadata)
+        {
+            Metadata = metadata ?? new RpcMetadata();
+        }
+    }
+}
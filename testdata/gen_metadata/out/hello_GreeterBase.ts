@@ -0,0 +1,68 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Server: GreeterServiceBase
+
+// Import encoding/decoding functions for each method
+import { decodeHelloRequest, encodeHelloReply } from './Greeter';
+import { RpcCallContext, RpcMetadata } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * Service definition structure
+ */
+export interface ServiceDefinition {
+  methodHandlers: {
+    [key: string]: (reqBytes: Uint8Array, metadata?: RpcMetadata) => Promise<Uint8Array>;
+  };
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Abstract class for Greeter server implementation
+ * Users (server implementors) should inherit this class and implement the methods.
+ */
+export abstract class GreeterBase {
+  
+  /**
+   * SayHello method
+   * @param requestObj - HelloRequest object
+   * @param context - carries the metadata sent with the call
+   * @returns Promise resolving to HelloReply
+   */
+  abstract SayHello(requestObj: HelloRequest, context: RpcCallContext): Promise<HelloReply>;
+  
+}
+
+/**
+ * Service binding utility
+ * Binds a service implementation to create a ServiceDefinition
+ */
+export class Greeter {
+  static bindService(impl: GreeterBase): ServiceDefinition {
+    const def: ServiceDefinition = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Greeter.SayHello"] = async (reqBytes: Uint8Array, metadata?: RpcMetadata): Promise<Uint8Array> => {
+      const reqObj = decodeHelloRequest(reqBytes);
+      const respObj = await impl.SayHello(reqObj, { metadata: metadata ?? {} });
+      return encodeHelloReply(respObj);
+    };
+    
+
+    return def;
+  }
+}
@@ -0,0 +1,13 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Per-call metadata (auth tokens, locale, ...) sent alongside the request message.
+ * @typedef {Object<string, string>} RpcMetadata
+ */
+
+/**
+ * Context of a call received by a generated server base.
+ * @typedef {Object} RpcCallContext
+ * @property {RpcMetadata} metadata
+ */
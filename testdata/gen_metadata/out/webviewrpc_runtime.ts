@@ -0,0 +1,14 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Per-call metadata (auth tokens, locale, ...) sent alongside the request message
+ */
+export type RpcMetadata = Record<string, string>;
+
+/**
+ * Context of a call received by a generated server base
+ */
+export interface RpcCallContext {
+  metadata: RpcMetadata;
+}
@@ -0,0 +1,83 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support types shared by the generated clients and servers
+using System;
+using System.Collections.Generic;
+using Cysharp.Threading.Tasks;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Response of a traced call together with the trace id sent in its request frame.
+    /// </summary>
+    public readonly struct TracedResponse<T>
+    {
+        public T Response { get; }
+        public string TraceId { get; }
+
+        public TracedResponse(T response, string traceId)
+        {
+            Response = response;
+            TraceId = traceId;
+        }
+    }
+
+    /// <summary>
+    /// Raised when a traced call fails, the original error is the InnerException.
+    /// </summary>
+    public class RpcTraceException : Exception
+    {
+        public string TraceId { get; }
+
+        public RpcTraceException(string traceId, Exception inner)
+            : base($"RPC call failed (trace id {traceId}): {inner.Message}", inner)
+        {
+            TraceId = traceId;
+        }
+    }
+
+    public static class RpcTrace
+    {
+        /// <summary>
+        /// Creates the trace id attached to an outgoing request frame.
+        /// </summary>
+        public static string NewTraceId()
+        {
+            return Guid.NewGuid().ToString();
+        }
+
+        /// <summary>
+        /// Awaits a transport call, tagging any failure with the trace id.
+        /// </summary>
+        public static async UniTask<T> Wrap<T>(string traceId, UniTask<T> call)
+        {
+            try
+            {
+                return await call;
+            }
+            catch (Exception e)
+            {
+                throw new RpcTraceException(traceId, e);
+            }
+        }
+    }
+
+    /// <summary>
+    /// Per-call metadata (auth tokens, locale, ...) sent alongside the request message.
+    /// </summary>
+    public sealed class RpcMetadata : Dictionary<string, string>
+    {
+    }
+
+    /// <summary>
+    /// Context of a call received by a generated server base.
+    /// </summary>
+    public sealed class RpcCallContext
+    {
+        public RpcMetadata Metadata { get; }
+
+        public RpcCallContext(RpcMetadata metadata)
+        {
+            Metadata = metadata ?? new RpcMetadata();
+        }
+    }
+}
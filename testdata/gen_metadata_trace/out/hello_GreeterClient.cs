@@ -0,0 +1,46 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<TracedResponse<HelloReply>> SayHello(HelloRequest request, RpcMetadata metadata = null);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        /// <param name="metadata">Per-call metadata passed to the transport alongside the request.</param>
+        public async UniTask<TracedResponse<HelloReply>> SayHello(HelloRequest request, RpcMetadata metadata = null)
+        {
+            var traceId = RpcTrace.NewTraceId();
+            var call = _rpcClient.CallMethod<HelloReply>("Greeter.SayHello", request, traceId, metadata);
+            var response = await RpcTrace.Wrap(traceId, call);
+            return new TracedResponse<HelloReply>(response, traceId);
+        }
+        
+    }
+}
@@ -0,0 +1,44 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { newTraceId, withTraceId } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @param {import('./webviewrpc_runtime.js').RpcMetadata} [metadata] per-call metadata passed to the transport
+   * @returns {Promise<{ response: HelloReply, traceId: string }>} rejects with RpcTraceError
+   */
+  async SayHello(requestObj, metadata = undefined) {
+    const traceId = newTraceId();
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    let call = this.rpcClient.callMethod("Greeter.SayHello", reqBytes, traceId, metadata);
+    const respBytes = await withTraceId(traceId, call);
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(respBytes);
+    return { response: respObj, traceId };
+  }
+  
+}
@@ -0,0 +1,64 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+import { Traced, RpcMetadata, newTraceId, withTraceId } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array, traceId?: string, metadata?: RpcMetadata): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @param metadata - per-call metadata passed to the transport
+   * @returns Promise resolving to Traced<HelloReply>, rejects with RpcTraceError
+   */
+  async SayHello(requestObj: HelloRequest, metadata?: RpcMetadata): Promise<Traced<HelloReply>> {
+    const traceId = newTraceId();
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    let call = this.rpcClient.callMethod("Greeter.SayHello", reqBytes, traceId, metadata);
+    const respBytes = await withTraceId(traceId, call);
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(respBytes);
+    return { response: respObj, traceId };
+  }
+  
+}
@@ -0,0 +1,73 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { withTimeout, OfflineQueue, transportConnected } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   * @param {import('./webviewrpc_runtime.js').RpcQueueStorage} [queueStorage] persists the calls queued while the transport is offline, which are kept in memory only without one
+   * @param {string} [queueKey] key of the queue in queueStorage; clients of Greeter sharing a storage each need their own
+   */
+  constructor(rpcClient, queueStorage = undefined, queueKey = "webviewrpc.queue." + GreeterServiceName) {
+    this.rpcClient = rpcClient;
+    /** unary calls made while the transport is offline */
+    this.offlineQueue = new OfflineQueue(queueStorage, queueKey, () => transportConnected(this.rpcClient), (method, payload, ...args) =>
+      this.rpcClient.callMethod(method, payload, ...args)
+    );
+    if (typeof globalThis.addEventListener === "function") {
+      globalThis.addEventListener("online", () => this.flushQueue().catch(() => {}));
+    }
+    // sends the calls queued in an earlier session
+    this.flushQueue().catch(() => {});
+  }
+
+  /**
+   * Sends the calls queued while the transport was offline, in order. Runs by
+   * itself when the browser goes online and with
+   * the next call; call it when the transport is back otherwise, e.g. the
+   * native bridge.
+   * @returns {Promise<void>} rejects when the queue storage fails
+   */
+  flushQueue() {
+    return this.offlineQueue.flush();
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @param {number} [timeoutMs=3000] call timeout, 0 disables it
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj, timeoutMs = 3000) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    // gen_offline_queue: while the transport is offline the call waits in the persistent queue
+    let call = this.offlineQueue.call("Greeter.SayHello", reqBytes);
+    const queued = call;
+    call = withTimeout(call, timeoutMs, "Greeter.SayHello");
+    // a call given up on while it waits in the offline queue is taken out rather than sent later
+    call = call.catch((e) => {
+      this.offlineQueue.remove(queued).catch(() => {});
+      throw e;
+    });
+    const respBytes = await call;
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
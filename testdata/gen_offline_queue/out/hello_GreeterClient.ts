@@ -0,0 +1,101 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+import { RpcQueueStorage, withTimeout, OfflineQueue, transportConnected } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+  /** WebSocket of the transport, if it has one */
+  socket?: { readyState: number };
+  /** whether the transport can reach the other side now, e.g. the native bridge is available */
+  isConnected?(): boolean;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  /** unary calls made while the transport is offline */
+  private offlineQueue: OfflineQueue;
+
+  /**
+   * @param rpcClient - transport of the calls
+   * @param queueStorage - persists the calls queued while the transport is offline, which are kept in memory only without one
+   * @param queueKey - key of the queue in queueStorage; clients of Greeter sharing a storage each need their own
+   */
+  constructor(rpcClient: WebViewRpcClient, queueStorage?: RpcQueueStorage, queueKey: string = "webviewrpc.queue." + GreeterServiceName) {
+    this.rpcClient = rpcClient;
+    this.offlineQueue = new OfflineQueue(queueStorage, queueKey, () => transportConnected(this.rpcClient), (method: string, payload: Uint8Array) =>
+      this.rpcClient.callMethod(method, payload)
+    );
+    if (typeof globalThis.addEventListener === "function") {
+      globalThis.addEventListener("online", () => this.flushQueue().catch(() => {}));
+    }
+    // sends the calls queued in an earlier session
+    this.flushQueue().catch(() => {});
+  }
+
+  /**
+   * Sends the calls queued while the transport was offline, in order. Runs by
+   * itself when the browser goes online and with
+   * the next call; call it when the transport is back otherwise, e.g. the
+   * native bridge. Rejects when the queue storage fails
+   */
+  flushQueue(): Promise<void> {
+    return this.offlineQueue.flush();
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @param timeoutMs - call timeout, defaults to 3000 ms, 0 disables it
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest, timeoutMs: number = 3000): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    // gen_offline_queue: while the transport is offline the call waits in the persistent queue
+    let call = this.offlineQueue.call("Greeter.SayHello", reqBytes);
+    const queued = call;
+    call = withTimeout(call, timeoutMs, "Greeter.SayHello");
+    // a call given up on while it waits in the offline queue is taken out rather than sent later
+    call = call.catch((e) => {
+      this.offlineQueue.remove(queued).catch(() => {});
+      throw e;
+    });
+    const respBytes = await call;
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,241 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Raised when a call does not complete within its timeout.
+ */
+export class RpcTimeoutError extends Error {
+  /**
+   * @param {string} method
+   * @param {number} timeoutMs
+   */
+  constructor(method, timeoutMs) {
+    super(`RPC call ${method} timed out after ${timeoutMs} ms`);
+    this.name = "RpcTimeoutError";
+    this.method = method;
+    this.timeoutMs = timeoutMs;
+  }
+}
+
+/**
+ * Rejects with RpcTimeoutError when call does not settle within timeoutMs.
+ * A timeoutMs of 0 or less disables the timeout.
+ * @template T
+ * @param {Promise<T>} call
+ * @param {number} timeoutMs
+ * @param {string} method
+ * @returns {Promise<T>}
+ */
+export function withTimeout(call, timeoutMs, method) {
+  if (!(timeoutMs > 0)) {
+    return call;
+  }
+  let timer;
+  const timeout = new Promise((_, reject) => {
+    timer = setTimeout(() => reject(new RpcTimeoutError(method, timeoutMs)), timeoutMs);
+  });
+  return Promise.race([call, timeout]).finally(() => clearTimeout(timer));
+}
+
+/**
+ * Storage the offline queue persists its calls in. localStorage qualifies;
+ * asynchronous stores such as IndexedDB or native storage through the bridge
+ * may return promises.
+ * @typedef {Object} RpcQueueStorage
+ * @property {function(string): (?string | Promise<?string>)} getItem
+ * @property {function(string, string): (void | Promise<void>)} setItem
+ */
+
+/**
+ * Whether rpcClient can reach the other side now: its isConnected() if it has
+ * one, e.g. to report the native bridge, else whether its WebSocket (socket)
+ * is open, else navigator.onLine.
+ * @param {Object} rpcClient
+ * @returns {boolean}
+ */
+export function transportConnected(rpcClient) {
+  if (typeof rpcClient.isConnected === "function") {
+    return rpcClient.isConnected();
+  }
+  if (rpcClient.socket) {
+    return rpcClient.socket.readyState === 1; // WebSocket.OPEN
+  }
+  return typeof navigator === "undefined" || navigator.onLine !== false;
+}
+
+/**
+ * Persistent FIFO queue of the unary calls a client makes while its transport
+ * is offline. Calls go straight to the transport while it is connected and
+ * nothing is queued. Otherwise they are appended to the queue, saved to
+ * storage under key, and settle once flush() sent them, one at a time in
+ * order. A call leaves the queue only after its response arrived, so it may
+ * be sent again if the app stops in between: delivery is at least once. Calls
+ * restored from storage, whose callers are gone, go first and their responses
+ * are dropped. A call given up on while queued is taken out with remove().
+ */
+export class OfflineQueue {
+  /**
+   * @param {RpcQueueStorage | undefined} storage the queue is kept in memory only without one
+   * @param {string} key
+   * @param {() => boolean} isConnected
+   * @param {function(string, Uint8Array, ...*): Promise<Uint8Array>} send transport call with the method, the request and further arguments
+   */
+  constructor(storage, key, isConnected, send) {
+    this.storage = storage;
+    this.key = key;
+    this.isConnected = isConnected;
+    this.send = send;
+    /** @type {Array<{ method: string, payload: Uint8Array, args: Array<*>, resolve: function(Uint8Array): void, reject: function(*): void, removed?: boolean }>} oldest first */
+    this.calls = [];
+    /** @type {WeakMap<Promise<Uint8Array>, Object>} call behind each promise returned by call(), for remove() */
+    this.entries = new WeakMap();
+    /** @type {Object | null} queued call handed to the transport by drain() */
+    this.sending = null;
+    /** @type {Promise<void> | null} */
+    this.flushing = null;
+    this.loaded = this.load();
+  }
+
+  async load() {
+    let restored = [];
+    try {
+      const stored = this.storage ? await this.storage.getItem(this.key) : null;
+      restored = stored ? JSON.parse(stored) : [];
+    } catch (e) {
+      // an unreadable queue is dropped rather than blocking every call
+    }
+    const ignore = () => {};
+    this.calls.unshift(...restored.map((c) => ({ method: c.method, payload: base64ToBytes(c.payload), args: [], resolve: ignore, reject: ignore })));
+  }
+
+  async save() {
+    if (this.storage) {
+      await this.storage.setItem(this.key, JSON.stringify(this.calls.map((c) => ({ method: c.method, payload: bytesToBase64(c.payload) }))));
+    }
+  }
+
+  /**
+   * Sends a call, or queues it while the transport is offline or earlier calls are queued.
+   * @param {string} method
+   * @param {Uint8Array} payload
+   * @param {...*} args further arguments of the transport call, not persisted
+   * @returns {Promise<Uint8Array>} rejects without queueing the call when storage fails
+   */
+  call(method, payload, ...args) {
+    const ignore = () => {};
+    const call = { method, payload, args, resolve: ignore, reject: ignore, removed: false };
+    const response = this.enqueue(call);
+    this.entries.set(response, call);
+    return response;
+  }
+
+  async enqueue(call) {
+    await this.loaded;
+    if (call.removed) {
+      throw new Error(`${call.method} was removed from the offline queue`);
+    }
+    if (this.calls.length === 0 && this.isConnected()) {
+      return this.send(call.method, call.payload, ...call.args);
+    }
+    const response = new Promise((resolve, reject) => {
+      call.resolve = resolve;
+      call.reject = reject;
+    });
+    this.calls.push(call);
+    try {
+      await this.save();
+    } catch (e) {
+      const index = this.calls.indexOf(call);
+      if (index >= 0) {
+        this.calls.splice(index, 1);
+      }
+      throw e;
+    }
+    this.flush().catch(() => {});
+    return response;
+  }
+
+  /**
+   * Takes a call given up on, e.g. timed out or cancelled, out of the queue so
+   * it is not sent later; its promise rejects. A call already handed to the
+   * transport is left alone.
+   * @param {Promise<Uint8Array>} response promise returned by call()
+   * @returns {Promise<void>} rejects when storage fails
+   */
+  async remove(response) {
+    const call = this.entries.get(response);
+    if (!call || call === this.sending) {
+      return;
+    }
+    call.removed = true;
+    const index = this.calls.indexOf(call);
+    if (index < 0) {
+      return;
+    }
+    this.calls.splice(index, 1);
+    call.reject(new Error(`${call.method} was removed from the offline queue`));
+    await this.save();
+  }
+
+  /**
+   * Sends the queued calls while the transport is connected.
+   * @returns {Promise<void>} rejects when storage fails
+   */
+  flush() {
+    if (!this.flushing) {
+      this.flushing = this.drain().finally(() => {
+        this.flushing = null;
+      });
+    }
+    return this.flushing;
+  }
+
+  async drain() {
+    await this.loaded;
+    while (this.calls.length > 0 && this.isConnected()) {
+      const call = this.calls[0];
+      let response;
+      let error;
+      let failed = false;
+      this.sending = call;
+      try {
+        response = await this.send(call.method, call.payload, ...call.args);
+      } catch (e) {
+        failed = true;
+        error = e;
+      } finally {
+        this.sending = null;
+      }
+      if (failed && !this.isConnected()) {
+        return; // the transport dropped again: the call stays first in the queue
+      }
+      this.calls.shift();
+      await this.save();
+      if (failed) {
+        call.reject(error);
+      } else {
+        call.resolve(response);
+      }
+    }
+  }
+}
+
+/**
+ * @param {Uint8Array} bytes
+ * @returns {string}
+ */
+function bytesToBase64(bytes) {
+  let binary = "";
+  for (const b of bytes) {
+    binary += String.fromCharCode(b);
+  }
+  return btoa(binary);
+}
+
+/**
+ * @param {string} text
+ * @returns {Uint8Array}
+ */
+function base64ToBytes(text) {
+  return Uint8Array.from(atob(text), (c) => c.charCodeAt(0));
+}
@@ -0,0 +1,235 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Raised when a call does not complete within its timeout
+ */
+export class RpcTimeoutError extends Error {
+  readonly method: string;
+  readonly timeoutMs: number;
+
+  constructor(method: string, timeoutMs: number) {
+    super(`RPC call ${method} timed out after ${timeoutMs} ms`);
+    this.name = "RpcTimeoutError";
+    this.method = method;
+    this.timeoutMs = timeoutMs;
+  }
+}
+
+/**
+ * Rejects with RpcTimeoutError when call does not settle within timeoutMs.
+ * A timeoutMs of 0 or less disables the timeout.
+ */
+export function withTimeout<T>(call: Promise<T>, timeoutMs: number, method: string): Promise<T> {
+  if (!(timeoutMs > 0)) {
+    return call;
+  }
+  let timer: ReturnType<typeof setTimeout> | undefined;
+  const timeout = new Promise<never>((_, reject) => {
+    timer = setTimeout(() => reject(new RpcTimeoutError(method, timeoutMs)), timeoutMs);
+  });
+  return Promise.race([call, timeout]).finally(() => clearTimeout(timer));
+}
+
+/**
+ * Storage the offline queue persists its calls in. localStorage qualifies;
+ * asynchronous stores such as IndexedDB or native storage through the bridge
+ * may return promises
+ */
+export interface RpcQueueStorage {
+  getItem(key: string): string | null | Promise<string | null>;
+  setItem(key: string, value: string): void | Promise<void>;
+}
+
+/**
+ * Whether rpcClient can reach the other side now: its isConnected() if it has
+ * one, e.g. to report the native bridge, else whether its WebSocket (socket)
+ * is open, else navigator.onLine
+ */
+export function transportConnected(rpcClient: { isConnected?(): boolean; socket?: { readyState: number } }): boolean {
+  if (typeof rpcClient.isConnected === "function") {
+    return rpcClient.isConnected();
+  }
+  if (rpcClient.socket) {
+    return rpcClient.socket.readyState === 1; // WebSocket.OPEN
+  }
+  return typeof navigator === "undefined" || navigator.onLine !== false;
+}
+
+interface QueuedCall {
+  method: string;
+  payload: Uint8Array;
+  /** further arguments of the transport call, not persisted */
+  args: unknown[];
+  resolve(response: Uint8Array): void;
+  reject(reason: unknown): void;
+  /** given up on by its caller through remove() */
+  removed?: boolean;
+}
+
+/**
+ * Persistent FIFO queue of the unary calls a client makes while its transport
+ * is offline. Calls go straight to the transport while it is connected and
+ * nothing is queued. Otherwise they are appended to the queue, saved to
+ * storage under key, and settle once flush() sent them, one at a time in
+ * order. A call leaves the queue only after its response arrived, so it may
+ * be sent again if the app stops in between: delivery is at least once. Calls
+ * restored from storage, whose callers are gone, go first and their responses
+ * are dropped. A call given up on while queued is taken out with remove()
+ */
+export class OfflineQueue {
+  private storage: RpcQueueStorage | undefined;
+  private key: string;
+  private isConnected: () => boolean;
+  private send: (method: string, payload: Uint8Array, ...args: any[]) => Promise<Uint8Array>;
+  /** oldest first */
+  private calls: QueuedCall[] = [];
+  /** call behind each promise returned by call(), for remove() */
+  private entries = new WeakMap<Promise<Uint8Array>, QueuedCall>();
+  /** queued call handed to the transport by drain() */
+  private sending: QueuedCall | null = null;
+  private flushing: Promise<void> | null = null;
+  private loaded: Promise<void>;
+
+  /**
+   * @param storage - the queue is kept in memory only without one
+   * @param send - transport call with the method, the request and further arguments
+   */
+  constructor(storage: RpcQueueStorage | undefined, key: string, isConnected: () => boolean, send: (method: string, payload: Uint8Array, ...args: any[]) => Promise<Uint8Array>) {
+    this.storage = storage;
+    this.key = key;
+    this.isConnected = isConnected;
+    this.send = send;
+    this.loaded = this.load();
+  }
+
+  private async load(): Promise<void> {
+    let restored: Array<{ method: string; payload: string }> = [];
+    try {
+      const stored = this.storage ? await this.storage.getItem(this.key) : null;
+      restored = stored ? JSON.parse(stored) : [];
+    } catch (e) {
+      // an unreadable queue is dropped rather than blocking every call
+    }
+    const ignore = () => {};
+    this.calls.unshift(...restored.map((c) => ({ method: c.method, payload: base64ToBytes(c.payload), args: [], resolve: ignore, reject: ignore })));
+  }
+
+  private async save(): Promise<void> {
+    if (this.storage) {
+      await this.storage.setItem(this.key, JSON.stringify(this.calls.map((c) => ({ method: c.method, payload: bytesToBase64(c.payload) }))));
+    }
+  }
+
+  /**
+   * Sends a call, or queues it while the transport is offline or earlier calls
+   * are queued; rejects without queueing the call when storage fails
+   */
+  call(method: string, payload: Uint8Array, ...args: unknown[]): Promise<Uint8Array> {
+    const ignore = () => {};
+    const call: QueuedCall = { method, payload, args, resolve: ignore, reject: ignore, removed: false };
+    const response = this.enqueue(call);
+    this.entries.set(response, call);
+    return response;
+  }
+
+  private async enqueue(call: QueuedCall): Promise<Uint8Array> {
+    await this.loaded;
+    if (call.removed) {
+      throw new Error(`${call.method} was removed from the offline queue`);
+    }
+    if (this.calls.length === 0 && this.isConnected()) {
+      return this.send(call.method, call.payload, ...call.args);
+    }
+    const response = new Promise<Uint8Array>((resolve, reject) => {
+      call.resolve = resolve;
+      call.reject = reject;
+    });
+    this.calls.push(call);
+    try {
+      await this.save();
+    } catch (e) {
+      const index = this.calls.indexOf(call);
+      if (index >= 0) {
+        this.calls.splice(index, 1);
+      }
+      throw e;
+    }
+    this.flush().catch(() => {});
+    return response;
+  }
+
+  /**
+   * Takes a call given up on, e.g. timed out or cancelled, out of the queue so
+   * it is not sent later; its promise rejects. A call already handed to the
+   * transport is left alone. Rejects when storage fails
+   * @param response - promise returned by call()
+   */
+  async remove(response: Promise<Uint8Array>): Promise<void> {
+    const call = this.entries.get(response);
+    if (!call || call === this.sending) {
+      return;
+    }
+    call.removed = true;
+    const index = this.calls.indexOf(call);
+    if (index < 0) {
+      return;
+    }
+    this.calls.splice(index, 1);
+    call.reject(new Error(`${call.method} was removed from the offline queue`));
+    await this.save();
+  }
+
+  /**
+   * Sends the queued calls while the transport is connected; rejects when storage fails
+   */
+  flush(): Promise<void> {
+    if (!this.flushing) {
+      this.flushing = this.drain().finally(() => {
+        this.flushing = null;
+      });
+    }
+    return this.flushing;
+  }
+
+  private async drain(): Promise<void> {
+    await this.loaded;
+    while (this.calls.length > 0 && this.isConnected()) {
+      const call = this.calls[0];
+      let response: Uint8Array | undefined;
+      let error: unknown;
+      let failed = false;
+      this.sending = call;
+      try {
+        response = await this.send(call.method, call.payload, ...call.args);
+      } catch (e) {
+        failed = true;
+        error = e;
+      } finally {
+        this.sending = null;
+      }
+      if (failed && !this.isConnected()) {
+        return; // the transport dropped again: the call stays first in the queue
+      }
+      this.calls.shift();
+      await this.save();
+      if (failed) {
+        call.reject(error);
+      } else {
+        call.resolve(response!);
+      }
+    }
+  }
+}
+
+function bytesToBase64(bytes: Uint8Array): string {
+  let binary = "";
+  for (const b of bytes) {
+    binary += String.fromCharCode(b);
+  }
+  return btoa(binary);
+}
+
+function base64ToBytes(text: string): Uint8Array {
+  return Uint8Array.from(atob(text), (c) => c.charCodeAt(0));
+}
@@ -0,0 +1,73 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        HelloReply SayHelloOptimistic(HelloRequest request, HelloReply optimistic, Action<HelloReply, HelloReply, Exception> reconcile);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        /// <summary>
+        /// Hands the result of call, or its exception, to the reconcile callback of an optimistic call.
+        /// </summary>
+        private static async UniTaskVoid Reconcile<TResponse, TResult>(UniTask<TResult> call, TResponse optimistic, Action<TResponse, TResult, Exception> reconcile)
+        {
+            TResult result;
+            try
+            {
+                result = await call;
+            }
+            catch (Exception e)
+            {
+                reconcile(optimistic, default, e);
+                return;
+            }
+            reconcile(optimistic, result, null);
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            var response = await _rpcClient.CallMethod<HelloReply>("Greeter.SayHello", request);
+            return response;
+        }
+
+        /// <summary>
+        /// SayHello with an optimistic response: returns optimistic at once for the UI to show,
+        /// then calls reconcile exactly once when the call settles, with the actual result or the exception.
+        /// </summary>
+        public HelloReply SayHelloOptimistic(HelloRequest request, HelloReply optimistic, Action<HelloReply, HelloReply, Exception> reconcile)
+        {
+            Reconcile(SayHello(request), optimistic, reconcile).Forget();
+            return optimistic;
+        }
+        
+    }
+}
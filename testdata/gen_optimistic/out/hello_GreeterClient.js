@@ -0,0 +1,56 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+
+  /**
+   * SayHello with an optimistic response: returns optimisticResponse at once for the UI to
+   * show, then calls reconcile exactly once when the call settles, with the actual result or the error
+   * @param { HelloRequest } requestObj
+   * @param { HelloReply } optimisticResponse
+   * @param {(optimistic: HelloReply, result: HelloReply | undefined, error: Error | undefined) => void} reconcile
+   * @returns { HelloReply } optimisticResponse
+   */
+  SayHelloOptimistic(requestObj, optimisticResponse, reconcile) {
+    this.SayHello(requestObj).then(
+      (result) => reconcile(optimisticResponse, result, undefined),
+      (error) => reconcile(optimisticResponse, undefined, error)
+    );
+    return optimisticResponse;
+  }
+  
+}
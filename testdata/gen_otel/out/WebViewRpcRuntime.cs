@@ -0,0 +1,69 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support types shared by the generated clients and servers
+using System;
+using System.Diagnostics;
+using Cysharp.Threading.Tasks;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Span of one client call, started by an IRpcTracer.
+    /// </summary>
+    public interface IRpcSpan
+    {
+        void SetAttribute(string key, object value);
+
+        /// <summary>
+        /// Ends the span; error is the exception the call failed with, null when it succeeded.
+        /// </summary>
+        void End(Exception error);
+    }
+
+    /// <summary>
+    /// Tracer the clients generated with gen_otel open a span of each unary call with, passed
+    /// to their constructor: typically an adapter over an OpenTelemetry tracer or ActivitySource.
+    /// </summary>
+    public interface IRpcTracer
+    {
+        IRpcSpan StartSpan(string name);
+    }
+
+    public static class RpcTelemetry
+    {
+        /// <summary>
+        /// Runs call inside a span of tracer named "service/method", with the attributes
+        /// rpc.system, rpc.service and rpc.method, and rpc.status ("ok" or "error") and
+        /// rpc.duration_ms once it completed. Without a tracer call just runs.
+        /// </summary>
+        public static async UniTask<T> Span<T>(IRpcTracer tracer, string service, string method, Func<UniTask<T>> call)
+        {
+            if (tracer == null)
+            {
+                return await call();
+            }
+            var span = tracer.StartSpan(service + "/" + method);
+            span.SetAttribute("rpc.system", "webviewrpc");
+            span.SetAttribute("rpc.service", service);
+            span.SetAttribute("rpc.method", method);
+            var started = Stopwatch.GetTimestamp();
+            try
+            {
+                var result = await call();
+                End(span, started, null);
+                return result;
+            }
+            catch (Exception e)
+            {
+                End(span, started, e);
+                throw;
+            }
+        }
+
+        private static void End(IRpcSpan span, long started, Exception error)
+        {
+            span.SetAttribute("rpc.status", error == null ? "ok" : "error");
+            span.SetAttribute("rpc.duration_ms", (Stopwatch.GetTimestamp() - started) * 1000.0 / Stopwatch.Frequency);
+            span.End(error);
+        }
+    }
+}
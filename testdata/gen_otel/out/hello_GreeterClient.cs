@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+        private readonly IRpcTracer _tracer;
+
+        /// <param name="tracer">Opens a span of each unary call, which is not traced when null.</param>
+        public GreeterClient(WebViewRpcClient rpcClient, IRpcTracer tracer = null)
+        {
+            this._rpcClient = rpcClient;
+            this._tracer = tracer;
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            // gen_otel: the call runs inside a span of the tracer
+            return await RpcTelemetry.Span(_tracer, ServiceName, "SayHello", () => TracedSayHello(request));
+        }
+
+        /// <summary>
+        /// SayHello inside its span.
+        /// </summary>
+        private async UniTask<HelloReply> TracedSayHello(HelloRequest request)
+        {
+            var response = await _rpcClient.CallMethod<HelloReply>("Greeter.SayHello", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,52 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Span of one client call, started by an RpcTracer.
+ * @typedef {Object} RpcSpan
+ * @property {function(string, (string|number)): void} setAttribute
+ * @property {function(?Error): void} end ends the span with the error the call failed with, null when it succeeded
+ */
+
+/**
+ * Tracer the clients generated with gen_otel open a span of each unary call
+ * with, passed to their constructor: typically an adapter over an
+ * OpenTelemetry tracer.
+ * @typedef {Object} RpcTracer
+ * @property {function(string): RpcSpan} startSpan
+ */
+
+/**
+ * Runs call inside a span of tracer named "service/method", with the
+ * attributes rpc.system, rpc.service and rpc.method, and rpc.status ("ok" or
+ * "error") and rpc.duration_ms once it settled. Without a tracer call just runs.
+ * @template T
+ * @param {RpcTracer | undefined} tracer
+ * @param {string} service
+ * @param {string} method
+ * @param {function(): Promise<T>} call
+ * @returns {Promise<T>}
+ */
+export async function withSpan(tracer, service, method, call) {
+  if (!tracer) {
+    return call();
+  }
+  const span = tracer.startSpan(`${service}/${method}`);
+  span.setAttribute("rpc.system", "webviewrpc");
+  span.setAttribute("rpc.service", service);
+  span.setAttribute("rpc.method", method);
+  const started = performance.now();
+  const end = (status, error) => {
+    span.setAttribute("rpc.status", status);
+    span.setAttribute("rpc.duration_ms", performance.now() - started);
+    span.end(error);
+  };
+  try {
+    const result = await call();
+    end("ok", null);
+    return result;
+  } catch (e) {
+    end("error", e);
+    throw e;
+  }
+}
@@ -0,0 +1,49 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Span of one client call, started by an RpcTracer
+ */
+export interface RpcSpan {
+  setAttribute(key: string, value: string | number): void;
+  /** ends the span with the error the call failed with, null when it succeeded */
+  end(error: unknown): void;
+}
+
+/**
+ * Tracer the clients generated with gen_otel open a span of each unary call
+ * with, passed to their constructor: typically an adapter over an
+ * OpenTelemetry tracer
+ */
+export interface RpcTracer {
+  startSpan(name: string): RpcSpan;
+}
+
+/**
+ * Runs call inside a span of tracer named "service/method", with the
+ * attributes rpc.system, rpc.service and rpc.method, and rpc.status ("ok" or
+ * "error") and rpc.duration_ms once it settled. Without a tracer call just runs
+ */
+export async function withSpan<T>(tracer: RpcTracer | undefined, service: string, method: string, call: () => Promise<T>): Promise<T> {
+  if (!tracer) {
+    return call();
+  }
+  const span = tracer.startSpan(`${service}/${method}`);
+  span.setAttribute("rpc.system", "webviewrpc");
+  span.setAttribute("rpc.service", service);
+  span.setAttribute("rpc.method", method);
+  const started = performance.now();
+  const end = (status: string, error: unknown) => {
+    span.setAttribute("rpc.status", status);
+    span.setAttribute("rpc.duration_ms", performance.now() - started);
+    span.end(error);
+  };
+  try {
+    const result = await call();
+    end("ok", null);
+    return result;
+  } catch (e) {
+    end("error", e);
+    throw e;
+  }
+}
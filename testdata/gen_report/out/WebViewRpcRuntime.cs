@@ -0,0 +1,154 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support types shared by the generated clients and servers
+using System;
+using System.IO;
+using System.Text;
+using Cysharp.Threading.Tasks;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Raised by RpcCallEnvelope.Open for a response envelope with the error status:
+    /// the server method failed with the message.
+    /// </summary>
+    public class RpcCallException : Exception
+    {
+        public string Service { get; }
+        public string Method { get; }
+        public string RequestId { get; }
+
+        public RpcCallException(string service, string method, string requestId, string message)
+            : base($"RPC call {service}.{method} failed: {message}")
+        {
+            Service = service;
+            Method = method;
+            RequestId = requestId;
+        }
+    }
+
+    /// <summary>
+    /// Wrapper of every request and response with gen_envelope, naming the call it belongs to.
+    /// Wire format, all integers uint32 little-endian: service, method and request id
+    /// (each length + UTF-8), a status byte, then the payload (length + message bytes,
+    /// or UTF-8 error message with StatusError).
+    /// </summary>
+    public sealed class RpcCallEnvelope
+    {
+        public const byte StatusOk = 0;
+        public const byte StatusError = 1;
+
+        public string Service { get; }
+        public string Method { get; }
+        public string RequestId { get; }
+        public byte Status { get; }
+        public byte[] Payload { get; }
+
+        public RpcCallEnvelope(string service, string method, string requestId, byte[] payload, byte status = StatusOk)
+        {
+            Service = service;
+            Method = method;
+            RequestId = requestId;
+            Payload = payload;
+            Status = status;
+        }
+
+        /// <summary>
+        /// Response envelope reporting that the server method failed with message.
+        /// </summary>
+        public static RpcCallEnvelope Error(string service, string method, string requestId, string message)
+        {
+            return new RpcCallEnvelope(service, method, requestId, Encoding.UTF8.GetBytes(message), StatusError);
+        }
+
+        /// <summary>
+        /// Creates the id pairing a request envelope with its response.
+        /// </summary>
+        public static string NewRequestId()
+        {
+            return Guid.NewGuid().ToString();
+        }
+
+        public byte[] Encode()
+        {
+            var output = new MemoryStream();
+            WriteBytes(output, Encoding.UTF8.GetBytes(Service));
+            WriteBytes(output, Encoding.UTF8.GetBytes(Method));
+            WriteBytes(output, Encoding.UTF8.GetBytes(RequestId));
+            output.WriteByte(Status);
+            WriteBytes(output, Payload);
+            return output.ToArray();
+        }
+
+        /// <summary>
+        /// Decodes an envelope, throwing when it was sent for another method.
+        /// </summary>
+        public static RpcCallEnvelope Decode(byte[] bytes, string service, string method)
+        {
+            var pos = 0;
+            var envelopeService = Encoding.UTF8.GetString(ReadBytes(bytes, ref pos));
+            var envelopeMethod = Encoding.UTF8.GetString(ReadBytes(bytes, ref pos));
+            var requestId = Encoding.UTF8.GetString(ReadBytes(bytes, ref pos));
+            if (pos >= bytes.Length)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            var status = bytes[pos++];
+            var envelope = new RpcCallEnvelope(envelopeService, envelopeMethod, requestId, ReadBytes(bytes, ref pos), status);
+            if (envelope.Service != service || envelope.Method != method)
+            {
+                throw new InvalidOperationException($"Envelope of {envelope.Service}.{envelope.Method} received by {service}.{method}");
+            }
+            return envelope;
+        }
+
+        /// <summary>
+        /// Payload of a response envelope, throwing when it answers another request and
+        /// RpcCallException when it carries StatusError.
+        /// </summary>
+        public static byte[] Open(byte[] bytes, string service, string method, string requestId)
+        {
+            var envelope = Decode(bytes, service, method);
+            if (envelope.RequestId != requestId)
+            {
+                throw new InvalidOperationException($"Response to request {envelope.RequestId} received for request {requestId}");
+            }
+            if (envelope.Status == StatusError)
+            {
+                throw new RpcCallException(service, method, requestId, Encoding.UTF8.GetString(envelope.Payload));
+            }
+            if (envelope.Status != StatusOk)
+            {
+                throw new FormatException($"Unknown envelope status {envelope.Status}");
+            }
+            return envelope.Payload;
+        }
+
+        private static byte[] ReadBytes(byte[] bytes, ref int pos)
+        {
+            if (pos + 4 > bytes.Length)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            var length = (uint)(bytes[pos] | bytes[pos + 1] << 8 | bytes[pos + 2] << 16 | bytes[pos + 3] << 24);
+            pos += 4;
+            if (length > bytes.Length - pos)
+            {
+                throw new FormatException("Truncated envelope");
+            }
+            var value = new byte[length];
+            Array.Copy(bytes, pos, value, 0, (int)length);
+            pos += (int)length;
+            return value;
+        }
+
+        private static void WriteBytes(MemoryStream output, byte[] value)
+        {
+            var length = (uint)value.Length;
+            output.WriteByte((byte)length);
+            output.WriteByte((byte)(length >> 8));
+            output.WriteByte((byte)(length >> 16));
+            output.WriteByte((byte)(length >> 24));
+            output.Write(value, 0, value.Length);
+        }
+    }
+}
@@ -0,0 +1,39 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support types shared by the generated clients and servers
+using System;
+using Google.Protobuf;
+using Cysharp.Threading.Tasks;
+
+namespace WebViewRPC
+{
+    /// <summary>
+    /// Serialization of the generated clients with gen_serializer, passed to their constructor
+    /// so the wire format can be swapped (e.g. for JSON) without regenerating.
+    /// ProtobufSerializer is used when none is given.
+    /// </summary>
+    public interface ISerializer
+    {
+        byte[] Serialize<T>(T message) where T : IMessage, new();
+        T Deserialize<T>(byte[] bytes) where T : IMessage, new();
+    }
+
+    /// <summary>
+    /// ISerializer writing the protobuf binary format.
+    /// </summary>
+    public sealed class ProtobufSerializer : ISerializer
+    {
+        public static readonly ProtobufSerializer Instance = new ProtobufSerializer();
+
+        public byte[] Serialize<T>(T message) where T : IMessage, new()
+        {
+            return message.ToByteArray();
+        }
+
+        public T Deserialize<T>(byte[] bytes) where T : IMessage, new()
+        {
+            var message = new T();
+            message.MergeFrom(bytes);
+            return message;
+        }
+    }
+}
@@ -0,0 +1,47 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+        private readonly ISerializer _serializer;
+
+        /// <param name="serializer">Serialization of the unary calls, ProtobufSerializer when null.</param>
+        public GreeterClient(WebViewRpcClient rpcClient, ISerializer serializer = null)
+        {
+            this._rpcClient = rpcClient;
+            this._serializer = serializer ?? ProtobufSerializer.Instance;
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            var call = _rpcClient.CallMethodRaw("Greeter.SayHello", _serializer.Serialize(request));
+            var response = _serializer.Deserialize<HelloReply>(await call);
+            return response;
+        }
+        
+    }
+}
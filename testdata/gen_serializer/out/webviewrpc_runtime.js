@@ -0,0 +1,29 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Message type handed to a serializer: its proto name and codec functions
+ * (encode for requests, decode for responses).
+ * @typedef {Object} RpcMessageType
+ * @property {string} name full proto name, e.g. "helloworld.HelloRequest"
+ * @property {(message: Object) => Uint8Array} [encode]
+ * @property {(bytes: Uint8Array) => Object} [decode]
+ */
+
+/**
+ * Serialization of the clients generated with gen_serializer, taken from the
+ * `serializer` property of the transport when it has one, so the wire format
+ * can be swapped (e.g. for JSON) without regenerating.
+ * @typedef {Object} RpcSerializer
+ * @property {(message: Object, type: RpcMessageType) => Uint8Array} serialize
+ * @property {(bytes: Uint8Array, type: RpcMessageType) => Object} deserialize
+ */
+
+/**
+ * Default RpcSerializer: the protobuf binary format, through the codec functions.
+ * @type {RpcSerializer}
+ */
+export const protobufSerializer = Object.freeze({
+  serialize: (message, type) => type.encode(message),
+  deserialize: (bytes, type) => type.decode(bytes),
+});
@@ -0,0 +1,35 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Message type handed to a serializer: its proto name and codec functions
+ * (encode for requests, decode for responses)
+ */
+export interface RpcMessageType<T> {
+  /** full proto name, e.g. "helloworld.HelloRequest" */
+  name: string;
+  encode?: (message: T) => Uint8Array;
+  decode?: (bytes: Uint8Array) => T;
+}
+
+/**
+ * Serialization of the clients generated with gen_serializer, taken from the
+ * `serializer` property of the transport when it has one, so the wire format
+ * can be swapped (e.g. for JSON) without regenerating
+ */
+export interface RpcSerializer {
+  serialize<T>(message: T, type: RpcMessageType<T>): Uint8Array;
+  deserialize<T>(bytes: Uint8Array, type: RpcMessageType<T>): T;
+}
+
+/**
+ * Default RpcSerializer: the protobuf binary format, through the codec functions
+ */
+export const protobufSerializer: RpcSerializer = Object.freeze({
+  serialize<T>(message: T, type: RpcMessageType<T>): Uint8Array {
+    return type.encode!(message);
+  },
+  deserialize<T>(bytes: Uint8Array, type: RpcMessageType<T>): T {
+    return type.decode!(bytes);
+  },
+});
@@ -0,0 +1,59 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+        private readonly RpcSigner _signer;
+
+        /// <param name="signer">Signs the request of each unary call, which is sent unsigned when null.</param>
+        public GreeterClient(WebViewRpcClient rpcClient, RpcSigner signer = null)
+        {
+            this._rpcClient = rpcClient;
+            this._signer = signer;
+        }
+
+        /// <summary>
+        /// Signature of the serialized request of method ("Greeter.Method"), empty without a signer.
+        /// </summary>
+        private async UniTask<byte[]> Sign(string method, byte[] payload)
+        {
+            return _signer != null ? await _signer(method, payload) : Array.Empty<byte>();
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            var requestId = RpcCallEnvelope.NewRequestId();
+            var reqBytes = request.ToByteArray();
+            var signature = await Sign("Greeter.SayHello", reqBytes);
+            var call = _rpcClient.CallMethodRaw("Greeter.SayHello", new RpcCallEnvelope("Greeter", "SayHello", requestId, reqBytes, signature: signature).Encode());
+            var response = HelloReply.Parser.ParseFrom(RpcCallEnvelope.Open(await call, "Greeter", "SayHello", requestId));
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { newRequestId, encodeEnvelope, openEnvelope } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   * @param {import('./webviewrpc_runtime.js').RpcSigner} [signer] signs the request of each unary call, which is sent unsigned without one
+   */
+  constructor(rpcClient, signer = undefined) {
+    this.rpcClient = rpcClient;
+    this.signer = signer;
+  }
+
+  /**
+   * Signature of the encoded request of method ("Greeter.Method"), empty without a signer
+   * @param {string} method
+   * @param {Uint8Array} reqBytes
+   * @returns {Promise<Uint8Array>}
+   */
+  async sign(method, reqBytes) {
+    return this.signer ? await this.signer(method, reqBytes) : new Uint8Array(0);
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const requestId = newRequestId();
+    const signature = await this.sign("Greeter.SayHello", reqBytes);
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", encodeEnvelope("Greeter", "SayHello", requestId, reqBytes, 0, signature));
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(openEnvelope(respBytes, "Greeter", "SayHello", requestId));
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,77 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+import { RpcSigner, newRequestId, encodeEnvelope, openEnvelope } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+  /** signs the request of each unary call, unsigned when undefined */
+  private signer: RpcSigner | undefined;
+
+  /**
+   * @param rpcClient - transport of the calls
+   * @param signer - signs the request of each unary call, which is sent unsigned without one
+   */
+  constructor(rpcClient: WebViewRpcClient, signer?: RpcSigner) {
+    this.rpcClient = rpcClient;
+    this.signer = signer;
+  }
+
+  /**
+   * Signature of the encoded request of method ("Greeter.Method"), empty without a signer
+   */
+  private async sign(method: string, reqBytes: Uint8Array): Promise<Uint8Array> {
+    return this.signer ? await this.signer(method, reqBytes) : new Uint8Array(0);
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    const requestId = newRequestId();
+    const signature = await this.sign("Greeter.SayHello", reqBytes);
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", encodeEnvelope("Greeter", "SayHello", requestId, reqBytes, 0, signature));
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(openEnvelope(respBytes, "Greeter", "SayHello", requestId));
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,135 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: FeedClient
+
+// Import encoding/decoding functions for each method
+import { encodeTopic, decodeUpdate } from './Feed.js';
+
+/**
+ * Fully-qualified proto name of Feed, for routing and logging
+ */
+export const FeedServiceName = "live.Feed";
+
+export class FeedClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param {string} method - name of the streaming method: Subscribe
+   * @param {Object} requestObj - request object of the method
+   * @param {(response: Object) => void} callback - invoked with each decoded response
+   * @returns {() => void} function that cancels the subscription
+   */
+  subscribe(method, requestObj, callback) {
+    const codecs = {
+      Subscribe: [encodeTopic, decodeUpdate],
+    };
+    if (!Object.prototype.hasOwnProperty.call(codecs, method)) {
+      throw new Error(`Feed.${method} is not a server-streaming method`);
+    }
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    return this.rpcClient.callServerStreamingMethod(
+      "Feed." + method,
+      reqBytes,
+      (respBytes) => callback(decode(respBytes))
+    );
+  }
+
+  
+  /**
+   * async Get
+   * Sends a Topic and returns an Update.
+   * @param { Topic } requestObj
+   * @returns {Promise< Update >}
+   */
+  async Get(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeTopic(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Feed.Get", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeUpdate(respBytes);
+    return respObj;
+  }
+  
+}
+
+/**
+ * Tracks the server-streaming subscriptions started through a FeedClient.
+ * Teardown contract: call dispose() when the owner of the streams goes away (e.g. on
+ * navigation); it cancels every subscription still tracked and makes start() throw.
+ * Streams stay tracked until cancelled, the transport does not report their end.
+ */
+export class FeedStreamManager {
+  /**
+   * @param {FeedClient } client
+   */
+  constructor(client) {
+    this.client = client;
+    /** @type {Map<number, () => void>} */
+    this.subscriptions = new Map();
+    this.nextId = 1;
+    this.disposed = false;
+  }
+
+  /**
+   * Starts a subscription, see FeedClient.subscribe
+   * @param {string} method
+   * @param {Object} requestObj
+   * @param {(response: Object) => void} callback
+   * @returns {number} id of the subscription, for cancel()
+   */
+  start(method, requestObj, callback) {
+    if (this.disposed) {
+      throw new Error("FeedStreamManager has been disposed");
+    }
+    const id = this.nextId++;
+    this.subscriptions.set(id, this.client.subscribe(method, requestObj, callback));
+    return id;
+  }
+
+  /**
+   * Cancels a subscription
+   * @param {number} id
+   * @returns {boolean} false when the id is unknown or already cancelled
+   */
+  cancel(id) {
+    const cancel = this.subscriptions.get(id);
+    if (!cancel) {
+      return false;
+    }
+    this.subscriptions.delete(id);
+    cancel();
+    return true;
+  }
+
+  /**
+   * Ids of the subscriptions not cancelled yet
+   * @returns {number[]}
+   */
+  activeIds() {
+    return [...this.subscriptions.keys()];
+  }
+
+  /**
+   * Cancels every tracked subscription, the manager stays usable
+   */
+  cancelAll() {
+    const cancels = [...this.subscriptions.values()];
+    this.subscriptions.clear();
+    cancels.forEach((cancel) => cancel());
+  }
+
+  /**
+   * Cancels every tracked subscription and refuses new ones
+   */
+  dispose() {
+    this.disposed = true;
+    this.cancelAll();
+  }
+}
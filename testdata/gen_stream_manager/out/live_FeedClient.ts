@@ -0,0 +1,175 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: FeedClient
+
+// Import encoding/decoding functions for each method
+import { encodeTopic, decodeUpdate } from './Feed';
+
+// Type definitions for request/response messages
+
+export interface Topic {
+  [key: string]: any;
+}
+
+export interface Update {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+  callServerStreamingMethod(methodName: string, reqBytes: Uint8Array, onMessage: (respBytes: Uint8Array) => void): () => void;
+}
+
+/**
+ * Fully-qualified proto name of Feed, for routing and logging
+ */
+export const FeedServiceName = "live.Feed";
+
+/**
+ * Server-streaming methods of Feed mapped to the response type they emit
+ */
+export interface FeedStreamEventMap {
+  Subscribe: Update;
+}
+
+/**
+ * Server-streaming methods of Feed mapped to their request type
+ */
+export interface FeedStreamRequestMap {
+  Subscribe: Topic;
+}
+
+/**
+ * Feed RPC Client
+ * Provides type-safe methods to call Feed on the server
+ */
+export class FeedClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param method - name of the streaming method, see FeedStreamEventMap
+   * @param requestObj - request object of the method
+   * @param callback - invoked with each decoded response
+   * @returns function that cancels the subscription
+   */
+  subscribe<K extends keyof FeedStreamEventMap>(
+    method: K,
+    requestObj: FeedStreamRequestMap[K],
+    callback: (response: FeedStreamEventMap[K]) => void
+  ): () => void {
+    const codecs: {
+      [M in keyof FeedStreamEventMap]: [
+        (obj: FeedStreamRequestMap[M]) => Uint8Array,
+        (bytes: Uint8Array) => FeedStreamEventMap[M]
+      ];
+    } = {
+      Subscribe: [encodeTopic, decodeUpdate],
+    };
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    return this.rpcClient.callServerStreamingMethod(
+      "Feed." + method,
+      reqBytes,
+      (respBytes) => callback(decode(respBytes))
+    );
+  }
+
+  
+  /**
+   * Call Get method
+   * Sends a Topic and returns an Update.
+   * @param requestObj - Topic object
+   * @returns Promise resolving to Update
+   */
+  async Get(requestObj: Topic): Promise<Update> {
+    // Encode request object to bytes
+    const reqBytes = encodeTopic(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Feed.Get", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeUpdate(respBytes);
+    return respObj;
+  }
+  
+}
+
+/**
+ * Tracks the server-streaming subscriptions started through a FeedClient.
+ * Teardown contract: call dispose() when the owner of the streams goes away (e.g. on
+ * navigation); it cancels every subscription still tracked and makes start() throw.
+ * Streams stay tracked until cancelled, the transport does not report their end.
+ */
+export class FeedStreamManager {
+  private client: FeedClient;
+  private subscriptions = new Map<number, () => void>();
+  private nextId = 1;
+  private disposed = false;
+
+  constructor(client: FeedClient) {
+    this.client = client;
+  }
+
+  /**
+   * Starts a subscription, see FeedClient.subscribe
+   * @returns id of the subscription, for cancel()
+   */
+  start<K extends keyof FeedStreamEventMap>(
+    method: K,
+    requestObj: FeedStreamRequestMap[K],
+    callback: (response: FeedStreamEventMap[K]) => void
+  ): number {
+    if (this.disposed) {
+      throw new Error("FeedStreamManager has been disposed");
+    }
+    const id = this.nextId++;
+    this.subscriptions.set(id, this.client.subscribe(method, requestObj, callback));
+    return id;
+  }
+
+  /**
+   * Cancels a subscription
+   * @returns false when the id is unknown or already cancelled
+   */
+  cancel(id: number): boolean {
+    const cancel = this.subscriptions.get(id);
+    if (!cancel) {
+      return false;
+    }
+    this.subscriptions.delete(id);
+    cancel();
+    return true;
+  }
+
+  /**
+   * Ids of the subscriptions not cancelled yet
+   */
+  activeIds(): number[] {
+    return [...this.subscriptions.keys()];
+  }
+
+  /**
+   * Cancels every tracked subscription, the manager stays usable
+   */
+  cancelAll(): void {
+    const cancels = [...this.subscriptions.values()];
+    this.subscriptions.clear();
+    cancels.forEach((cancel) => cancel());
+  }
+
+  /**
+   * Cancels every tracked subscription and refuses new ones
+   */
+  dispose(): void {
+    this.disposed = true;
+    this.cancelAll();
+  }
+}
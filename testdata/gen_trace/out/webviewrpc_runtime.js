@@ -0,0 +1,44 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Creates the trace id attached to an outgoing request frame.
+ * @returns {string}
+ */
+export function newTraceId() {
+  if (typeof crypto !== "undefined" && crypto.randomUUID) {
+    return crypto.randomUUID();
+  }
+  return Date.now().toString(16) + Math.random().toString(16).slice(2);
+}
+
+/**
+ * Raised when a traced call fails, the original error is kept as `cause`.
+ */
+export class RpcTraceError extends Error {
+  /**
+   * @param {string} traceId
+   * @param {*} cause
+   */
+  constructor(traceId, cause) {
+    super(`RPC call failed (trace id ${traceId}): ${cause && cause.message ? cause.message : cause}`);
+    this.name = "RpcTraceError";
+    this.traceId = traceId;
+    this.cause = cause;
+  }
+}
+
+/**
+ * Awaits a transport call, tagging any failure with the trace id.
+ * @template T
+ * @param {string} traceId
+ * @param {Promise<T>} call
+ * @returns {Promise<T>}
+ */
+export async function withTraceId(traceId, call) {
+  try {
+    return await call;
+  } catch (e) {
+    throw new RpcTraceError(traceId, e);
+  }
+}
@@ -0,0 +1,46 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Response of a traced call together with the trace id sent in its request frame
+ */
+export interface Traced<T> {
+  response: T;
+  traceId: string;
+}
+
+/**
+ * Creates the trace id attached to an outgoing request frame
+ */
+export function newTraceId(): string {
+  if (typeof crypto !== "undefined" && crypto.randomUUID) {
+    return crypto.randomUUID();
+  }
+  return Date.now().toString(16) + Math.random().toString(16).slice(2);
+}
+
+/**
+ * Raised when a traced call fails, the original error is kept as `cause`
+ */
+export class RpcTraceError extends Error {
+  readonly traceId: string;
+  readonly cause: unknown;
+
+  constructor(traceId: string, cause: unknown) {
+    super(`RPC call failed (trace id ${traceId}): ${cause instanceof Error ? cause.message : String(cause)}`);
+    this.name = "RpcTraceError";
+    this.traceId = traceId;
+    this.cause = cause;
+  }
+}
+
+/**
+ * Awaits a transport call, tagging any failure with the trace id
+ */
+export async function withTraceId<T>(traceId: string, call: Promise<T>): Promise<T> {
+  try {
+    return await call;
+  } catch (e) {
+    throw new RpcTraceError(traceId, e);
+  }
+}
@@ -0,0 +1,56 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Hostile
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class HostileBase
+    {
+        
+        public abstract UniTask<Resp> Go(Req request);
+        
+        public abstract UniTask<Resp> Watch(Req request);
+        
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Hostile
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "hostile.Hostile";
+
+        public static ServiceDefinition BindService(HostileBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Hostile.Go"] = async (reqBytes) =>
+            {
+                var req = new Req();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.Go(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+            def.MethodHandlers["Hostile.Watch"] = async (reqBytes) =>
+            {
+                var req = new Req();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.Watch(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+
+            return def;
+        }
+    }
+}
@@ -0,0 +1,68 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Server: HostileServiceBase
+
+// 메서드별 인코딩 함수를 가져옴
+// Get encoding/decoding functions for each method
+import { decodeReq, encodeResp } from './Hostile.js';
+
+/**
+ * Fully-qualified proto name of Hostile, for routing and logging
+ */
+export const HostileServiceName = "hostile.Hostile";
+
+/**
+ * 추상 클래스 (C#의 HostileBase)
+ * 사용자(서버구현자)는 이 클래스를 상속해서 실제 로직을 override한다.
+ * Abstract class (like C#'s HostileBase)
+ * Users (server implementors) should inherit this class and override the methods.
+ */
+export class HostileBase {
+  
+  /**
+   * async Go
+   * @param { Req } requestObj
+   * @returns {Promise< Resp >}
+   */
+  async Go(requestObj) {
+    throw new Error("Method Go must be implemented");
+  }
+  
+  /**
+   * async Watch
+   * @param { Req } requestObj
+   * @returns {Promise< Resp >}
+   */
+  async Watch(requestObj) {
+    throw new Error("Method Watch must be implemented");
+  }
+  
+}
+
+/**
+ * static BindService, (C#의 Hostile.BindService(impl))
+ * - impl: HostileBase implementation
+ * - return: ServiceDefinition(methodHandlers)
+ */
+export class Hostile {
+  static bindService(impl) {
+    const def = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Hostile.Go"] = async (reqBytes) => {
+      const reqObj = decodeReq(reqBytes);
+      const respObj = await impl.Go(reqObj);
+      return encodeResp(respObj);
+    };
+    
+    def.methodHandlers["Hostile.Watch"] = async (reqBytes) => {
+      const reqObj = decodeReq(reqBytes);
+      const respObj = await impl.Watch(reqObj);
+      return encodeResp(respObj);
+    };
+    
+
+    return def;
+  }
+}
@@ -0,0 +1,79 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Server: HostileServiceBase
+
+// Import encoding/decoding functions for each method
+import { decodeReq, encodeResp } from './Hostile';
+
+// Type definitions for request/response messages
+
+export interface Req {
+  [key: string]: any;
+}
+
+export interface Resp {
+  [key: string]: any;
+}
+
+/**
+ * Service definition structure
+ */
+export interface ServiceDefinition {
+  methodHandlers: {
+    [key: string]: (reqBytes: Uint8Array) => Promise<Uint8Array>;
+  };
+}
+
+/**
+ * Fully-qualified proto name of Hostile, for routing and logging
+ */
+export const HostileServiceName = "hostile.Hostile";
+
+/**
+ * Abstract class for Hostile server implementation
+ * Users (server implementors) should inherit this class and implement the methods.
+ */
+export abstract class HostileBase {
+  
+  /**
+   * Go method
+   * @param requestObj - Req object
+   * @returns Promise resolving to Resp
+   */
+  abstract Go(requestObj: Req): Promise<Resp>;
+  
+  /**
+   * Watch method
+   * @param requestObj - Req object
+   * @returns Promise resolving to Resp
+   */
+  abstract Watch(requestObj: Req): Promise<Resp>;
+  
+}
+
+/**
+ * Service binding utility
+ * Binds a service implementation to create a ServiceDefinition
+ */
+export class Hostile {
+  static bindService(impl: HostileBase): ServiceDefinition {
+    const def: ServiceDefinition = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Hostile.Go"] = async (reqBytes: Uint8Array): Promise<Uint8Array> => {
+      const reqObj = decodeReq(reqBytes);
+      const respObj = await impl.Go(reqObj);
+      return encodeResp(respObj);
+    };
+    
+    def.methodHandlers["Hostile.Watch"] = async (reqBytes: Uint8Array): Promise<Uint8Array> => {
+      const reqObj = decodeReq(reqBytes);
+      const respObj = await impl.Watch(reqObj);
+      return encodeResp(respObj);
+    };
+    
+
+    return def;
+  }
+}
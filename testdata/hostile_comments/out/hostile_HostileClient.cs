@@ -0,0 +1,63 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System.Collections.Generic;
+using System.Runtime.CompilerServices;
+using System.Threading;
+
+namespace Hostile
+{
+    public interface IHostileClient
+    {
+        
+        UniTask<Resp> Go(Req request);
+        
+        IAsyncEnumerable<Resp> WatchAsync(Req request, CancellationToken cancellationToken = default);
+        
+    }
+
+    /// <summary>
+    /// Hostile */ comment with &lt;tags&gt; &amp; "quotes" --&gt; end.
+    ///
+    /// /* nested */ and &lt;!-- html --&gt;
+    /// </summary>
+    public class HostileClient : IHostileClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "hostile.Hostile";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public HostileClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Returns a &lt; b &amp;&amp; c &gt; d */ oops
+        /// </summary>
+        public async UniTask<Resp> Go(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Resp>("Hostile.Go", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// streams */ &lt;T&gt;
+        /// Server-streaming call, yields each response frame as it arrives.
+        /// Cancelling the token stops the stream.
+        /// </summary>
+        public async IAsyncEnumerable<Resp> WatchAsync(Req request, [EnumeratorCancellation] CancellationToken cancellationToken = default)
+        {
+            await foreach (var response in _rpcClient.CallServerStreamingMethod<Resp>("Hostile.Watch", request, cancellationToken).WithCancellation(cancellationToken))
+            {
+                yield return response;
+            }
+        }
+        
+    }
+}
@@ -0,0 +1,65 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: HostileClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeResp } from './Hostile.js';
+
+/**
+ * Fully-qualified proto name of Hostile, for routing and logging
+ */
+export const HostileServiceName = "hostile.Hostile";
+
+/**
+ * Hostile *\/ comment with <tags> & "quotes" --> end.
+ *
+ * /* nested *\/ and <!-- html -->
+ */
+export class HostileClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param {string} method - name of the streaming method: Watch
+   * @param {Object} requestObj - request object of the method
+   * @param {(response: Object) => void} callback - invoked with each decoded response
+   * @returns {() => void} function that cancels the subscription
+   */
+  subscribe(method, requestObj, callback) {
+    const codecs = {
+      Watch: [encodeReq, decodeResp],
+    };
+    if (!Object.prototype.hasOwnProperty.call(codecs, method)) {
+      throw new Error(`Hostile.${method} is not a server-streaming method`);
+    }
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    return this.rpcClient.callServerStreamingMethod(
+      "Hostile." + method,
+      reqBytes,
+      (respBytes) => callback(decode(respBytes))
+    );
+  }
+
+  
+  /**
+   * async Go
+   * Returns a < b && c > d *\/ oops
+   * @param { Req } requestObj
+   * @returns {Promise< Resp >}
+   */
+  async Go(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeReq(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Hostile.Go", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,106 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: HostileClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeResp } from './Hostile';
+
+// Type definitions for request/response messages
+
+export interface Req {
+  [key: string]: any;
+}
+
+export interface Resp {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+  callServerStreamingMethod(methodName: string, reqBytes: Uint8Array, onMessage: (respBytes: Uint8Array) => void): () => void;
+}
+
+/**
+ * Fully-qualified proto name of Hostile, for routing and logging
+ */
+export const HostileServiceName = "hostile.Hostile";
+
+/**
+ * Server-streaming methods of Hostile mapped to the response type they emit
+ */
+export interface HostileStreamEventMap {
+  Watch: Resp;
+}
+
+/**
+ * Server-streaming methods of Hostile mapped to their request type
+ */
+export interface HostileStreamRequestMap {
+  Watch: Req;
+}
+
+/**
+ * Hostile RPC Client
+ * Provides type-safe methods to call Hostile on the server
+ * Hostile *\/ comment with <tags> & "quotes" --> end.
+ *
+ * /* nested *\/ and <!-- html -->
+ */
+export class HostileClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param method - name of the streaming method, see HostileStreamEventMap
+   * @param requestObj - request object of the method
+   * @param callback - invoked with each decoded response
+   * @returns function that cancels the subscription
+   */
+  subscribe<K extends keyof HostileStreamEventMap>(
+    method: K,
+    requestObj: HostileStreamRequestMap[K],
+    callback: (response: HostileStreamEventMap[K]) => void
+  ): () => void {
+    const codecs: {
+      [M in keyof HostileStreamEventMap]: [
+        (obj: HostileStreamRequestMap[M]) => Uint8Array,
+        (bytes: Uint8Array) => HostileStreamEventMap[M]
+      ];
+    } = {
+      Watch: [encodeReq, decodeResp],
+    };
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    return this.rpcClient.callServerStreamingMethod(
+      "Hostile." + method,
+      reqBytes,
+      (respBytes) => callback(decode(respBytes))
+    );
+  }
+
+  
+  /**
+   * Call Go method
+   * Returns a < b && c > d *\/ oops
+   * @param requestObj - Req object
+   * @returns Promise resolving to Resp
+   */
+  async Go(requestObj: Req): Promise<Resp> {
+    // Encode request object to bytes
+    const reqBytes = encodeReq(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Hostile.Go", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeResp(respBytes);
+    return respObj;
+  }
+  
+}
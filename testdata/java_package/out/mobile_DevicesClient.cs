@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Acme.Api
+{
+    public interface IDevicesClient
+    {
+        
+        UniTask<Device> Register(Device request);
+        
+    }
+
+    public class DevicesClient : IDevicesClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "acme.api.Devices";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public DevicesClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Device and returns a Device.
+        /// </summary>
+        public async UniTask<Device> Register(Device request)
+        {
+            var response = await _rpcClient.CallMethod<Device>("Devices.Register", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: DevicesClient
+
+// Import encoding/decoding functions for each method
+import { encodeDevice, decodeDevice } from './Devices.js';
+
+/**
+ * Fully-qualified proto name of Devices, for routing and logging
+ */
+export const DevicesServiceName = "acme.api.Devices";
+
+export class DevicesClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Register
+   * Sends a Device and returns a Device.
+   * @param { Device } requestObj
+   * @returns {Promise< Device >}
+   */
+  async Register(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeDevice(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Devices.Register", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeDevice(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,66 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: CountersClient
+
+// Import encoding/decoding functions for each method
+import { encodeDelta, decodeDelta } from './Counters';
+
+// Type definitions for request/response messages
+
+export interface Delta {
+  [key: string]: any;
+  unit?: DeltaUnit;
+}
+
+// Discriminated unions for oneof fields
+
+/**
+ * oneof unit of Delta, discriminated by $case
+ */
+export type DeltaUnit =
+  | { $case: "bytes"; bytes: number }
+  | { $case: "label"; label: string }
+  | { $case: undefined };
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Counters, for routing and logging
+ */
+export const CountersServiceName = "counters.Counters";
+
+/**
+ * Counters RPC Client
+ * Provides type-safe methods to call Counters on the server
+ */
+export class CountersClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Add method
+   * Sends a Delta and returns a Delta.
+   * @param requestObj - Delta object
+   * @returns Promise resolving to Delta
+   */
+  async Add(requestObj: Delta): Promise<Delta> {
+    // Encode request object to bytes
+    const reqBytes = encodeDelta(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Counters.Add", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeDelta(respBytes);
+    return respObj;
+  }
+  
+}
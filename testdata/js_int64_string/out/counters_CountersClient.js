@@ -0,0 +1,45 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: CountersClient
+
+// Import encoding/decoding functions for each method
+import { encodeDelta, decodeDelta } from './Counters.js';
+
+/**
+ * Fully-qualified proto name of Counters, for routing and logging
+ */
+export const CountersServiceName = "counters.Counters";
+
+/**
+ * @typedef {Object} Delta
+ * @property {string} [amount]
+ * @property {string} [total]
+ * @property {string} [bytes]
+ * @property {string} [label]
+ */
+
+export class CountersClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Add
+   * Sends a Delta and returns a Delta.
+   * @param { Delta } requestObj
+   * @returns {Promise< Delta >}
+   */
+  async Add(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeDelta(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Counters.Add", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeDelta(respBytes);
+    return respObj;
+  }
+  
+}
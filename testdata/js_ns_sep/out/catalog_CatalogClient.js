@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: CatalogClient
+
+// Import encoding/decoding functions for each method
+import { encodeacme__shop__v1__Query, decodeacme__shop__v1__Product } from './Catalog.js';
+
+/**
+ * Fully-qualified proto name of Catalog, for routing and logging
+ */
+export const CatalogServiceName = "acme.shop.v1.Catalog";
+
+export class CatalogClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Find
+   * Sends an acme__shop__v1__Query and returns an acme__shop__v1__Product.
+   * @param { acme__shop__v1__Query } requestObj
+   * @returns {Promise< acme__shop__v1__Product >}
+   */
+  async Find(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeacme__shop__v1__Query(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Catalog.Find", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeacme__shop__v1__Product(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,59 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: CatalogClient
+
+// Import encoding/decoding functions for each method
+import { encodeacme__shop__v1__Query, decodeacme__shop__v1__Product } from './Catalog';
+
+// Type definitions for request/response messages
+
+export interface acme__shop__v1__Query {
+  [key: string]: any;
+}
+
+export interface acme__shop__v1__Product {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Catalog, for routing and logging
+ */
+export const CatalogServiceName = "acme.shop.v1.Catalog";
+
+/**
+ * Catalog RPC Client
+ * Provides type-safe methods to call Catalog on the server
+ */
+export class CatalogClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Find method
+   * Sends an acme__shop__v1__Query and returns an acme__shop__v1__Product.
+   * @param requestObj - acme__shop__v1__Query object
+   * @returns Promise resolving to acme__shop__v1__Product
+   */
+  async Find(requestObj: acme__shop__v1__Query): Promise<acme__shop__v1__Product> {
+    // Encode request object to bytes
+    const reqBytes = encodeacme__shop__v1__Query(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Catalog.Find", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeacme__shop__v1__Product(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,66 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: JClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeReq } from './J';
+
+// Type definitions for request/response messages
+
+export interface Req {
+  [key: string]: any;
+  pick?: ReqPick;
+}
+
+// Discriminated unions for oneof fields
+
+/**
+ * oneof pick of Req, discriminated by $case
+ */
+export type ReqPick =
+  | { $case: "ID"; ID: string }
+  | { $case: "byMail"; byMail: string }
+  | { $case: undefined };
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of J, for routing and logging
+ */
+export const JServiceName = "jn.J";
+
+/**
+ * J RPC Client
+ * Provides type-safe methods to call J on the server
+ */
+export class JClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Go method
+   * Sends a Req and returns a Req.
+   * @param requestObj - Req object
+   * @returns Promise resolving to Req
+   */
+  async Go(requestObj: Req): Promise<Req> {
+    // Encode request object to bytes
+    const reqBytes = encodeReq(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("J.Go", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeReq(respBytes);
+    return respObj;
+  }
+  
+}
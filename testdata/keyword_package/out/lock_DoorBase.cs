@@ -0,0 +1,46 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Lock.Event
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class DoorBase
+    {
+        
+        public abstract UniTask<OpenReply> Open(OpenRequest request);
+        
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Door
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "lock.event.Door";
+
+        public static ServiceDefinition BindService(DoorBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Door.Open"] = async (reqBytes) =>
+            {
+                var req = new OpenRequest();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.Open(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+
+            return def;
+        }
+    }
+}
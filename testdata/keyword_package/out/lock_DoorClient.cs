@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Lock.Event
+{
+    public interface IDoorClient
+    {
+        
+        UniTask<OpenReply> Open(OpenRequest request);
+        
+    }
+
+    public class DoorClient : IDoorClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "lock.event.Door";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public DoorClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends an OpenRequest and returns an OpenReply.
+        /// </summary>
+        public async UniTask<OpenReply> Open(OpenRequest request)
+        {
+            var response = await _rpcClient.CallMethod<OpenReply>("Door.Open", request);
+            return response;
+        }
+        
+    }
+}
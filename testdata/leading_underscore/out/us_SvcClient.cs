@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Us
+{
+    public interface ISvcClient
+    {
+        
+        UniTask<Reply> Do(_Internal request);
+        
+    }
+
+    public class SvcClient : ISvcClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "us.Svc";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public SvcClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a _Internal and returns a Reply.
+        /// </summary>
+        public async UniTask<Reply> Do(_Internal request)
+        {
+            var response = await _rpcClient.CallMethod<Reply>("Svc.Do", request);
+            return response;
+        }
+        
+    }
+}
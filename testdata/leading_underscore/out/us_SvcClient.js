@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: SvcClient
+
+// Import encoding/decoding functions for each method
+import { encode_Internal, decodeReply } from './Svc.js';
+
+/**
+ * Fully-qualified proto name of Svc, for routing and logging
+ */
+export const SvcServiceName = "us.Svc";
+
+export class SvcClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Do
+   * Sends a _Internal and returns a Reply.
+   * @param { _Internal } requestObj
+   * @returns {Promise< Reply >}
+   */
+  async Do(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encode_Internal(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Svc.Do", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeReply(respBytes);
+    return respObj;
+  }
+  
+}
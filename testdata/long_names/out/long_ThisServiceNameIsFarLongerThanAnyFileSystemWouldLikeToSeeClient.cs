@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Long
+{
+    public interface IThisServiceNameIsFarLongerThanAnyFileSystemWouldLikeToSeeClient
+    {
+        
+        UniTask<Msg> Go(Msg request);
+        
+    }
+
+    public class ThisServiceNameIsFarLongerThanAnyFileSystemWouldLikeToSeeClient : IThisServiceNameIsFarLongerThanAnyFileSystemWouldLikeToSeeClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "long.ThisServiceNameIsFarLongerThanAnyFileSystemWouldLikeToSee";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public ThisServiceNameIsFarLongerThanAnyFileSystemWouldLikeToSeeClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Msg and returns a Msg.
+        /// </summary>
+        public async UniTask<Msg> Go(Msg request)
+        {
+            var response = await _rpcClient.CallMethod<Msg>("ThisServiceNameIsFarLongerThanAnyFileSystemWouldLikeToSee.Go", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,86 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+using System.Collections.Generic;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        /// <summary>
+        /// Most unary calls the client has in flight at once; further calls wait in a FIFO queue.
+        /// </summary>
+        public const int MaxConcurrent = 4;
+
+        private int _activeCalls;
+        private readonly Queue<UniTaskCompletionSource> _queuedCalls = new Queue<UniTaskCompletionSource>();
+
+        /// <summary>
+        /// Runs send once fewer than MaxConcurrent calls are in flight, in the order the calls were made.
+        /// </summary>
+        private async UniTask<T> Limited<T>(Func<UniTask<T>> send)
+        {
+            if (_activeCalls < MaxConcurrent)
+            {
+                _activeCalls++;
+            }
+            else
+            {
+                var slot = new UniTaskCompletionSource();
+                _queuedCalls.Enqueue(slot);
+                await slot.Task;
+            }
+            try
+            {
+                return await send();
+            }
+            finally
+            {
+                // the slot passes to the oldest queued call, if any
+                if (_queuedCalls.Count > 0)
+                {
+                    _queuedCalls.Dequeue().TrySetResult();
+                }
+                else
+                {
+                    _activeCalls--;
+                }
+            }
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            var response = await Limited(() => _rpcClient.CallMethod<HelloReply>("Greeter.SayHello", request));
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,77 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * Most unary calls the client has in flight at once; further calls wait in a FIFO queue.
+   */
+  static MAX_CONCURRENT = 4;
+
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+    this.activeCalls = 0;
+    /** @type {Array<() => void>} starts of the calls waiting for a slot, oldest first */
+    this.queuedCalls = [];
+  }
+
+  /**
+   * Runs send once fewer than MAX_CONCURRENT calls are in flight, in the order the calls were made
+   * @template T
+   * @param {() => Promise<T>} send
+   * @returns {Promise<T>}
+   */
+  limited(send) {
+    return new Promise((resolve, reject) => {
+      const start = () => {
+        this.activeCalls++;
+        Promise.resolve()
+          .then(send)
+          .then(resolve, reject)
+          .finally(() => {
+            this.activeCalls--;
+            const next = this.queuedCalls.shift();
+            if (next) {
+              next();
+            }
+          });
+      };
+      if (this.activeCalls < GreeterClient.MAX_CONCURRENT) {
+        start();
+      } else {
+        this.queuedCalls.push(start);
+      }
+    });
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.limited(() => this.rpcClient.callMethod("Greeter.SayHello", reqBytes));
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
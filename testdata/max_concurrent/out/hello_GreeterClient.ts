@@ -0,0 +1,95 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  /**
+   * Most unary calls the client has in flight at once; further calls wait in a FIFO queue.
+   */
+  static readonly MAX_CONCURRENT = 4;
+
+  private activeCalls = 0;
+  /** starts of the calls waiting for a slot, oldest first */
+  private queuedCalls: Array<() => void> = [];
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Runs send once fewer than MAX_CONCURRENT calls are in flight, in the order the calls were made
+   */
+  private limited<T>(send: () => Promise<T>): Promise<T> {
+    return new Promise<T>((resolve, reject) => {
+      const start = (): void => {
+        this.activeCalls++;
+        Promise.resolve()
+          .then(send)
+          .then(resolve, reject)
+          .finally(() => {
+            this.activeCalls--;
+            const next = this.queuedCalls.shift();
+            if (next) {
+              next();
+            }
+          });
+      };
+      if (this.activeCalls < GreeterClient.MAX_CONCURRENT) {
+        start();
+      } else {
+        this.queuedCalls.push(start);
+      }
+    });
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.limited(() => this.rpcClient.callMethod("Greeter.SayHello", reqBytes));
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
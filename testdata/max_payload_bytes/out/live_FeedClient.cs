@@ -0,0 +1,74 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+using System.Collections.Generic;
+using System.Runtime.CompilerServices;
+using System.Threading;
+
+namespace Live
+{
+    public interface IFeedClient
+    {
+        
+        UniTask<Update> Get(Topic request);
+        
+        IAsyncEnumerable<Update> SubscribeAsync(Topic request, CancellationToken cancellationToken = default);
+        
+    }
+
+    public class FeedClient : IFeedClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "live.Feed";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public FeedClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        /// <summary>
+        /// Largest serialized request the client sends, in bytes. Larger requests throw an ArgumentException.
+        /// </summary>
+        public const int MaxPayloadBytes = 65536;
+
+        private static void CheckPayloadSize(string method, int size)
+        {
+            if (size > MaxPayloadBytes)
+            {
+                throw new ArgumentException($"{method} request is {size} bytes, over the limit of {MaxPayloadBytes} bytes");
+            }
+        }
+
+        
+        /// <summary>
+        /// Sends a Topic and returns an Update.
+        /// </summary>
+        public async UniTask<Update> Get(Topic request)
+        {
+            CheckPayloadSize("Feed.Get", request.CalculateSize());
+            var response = await _rpcClient.CallMethod<Update>("Feed.Get", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a Topic and returns an Update.
+        /// Server-streaming call, yields each response frame as it arrives.
+        /// Cancelling the token stops the stream.
+        /// </summary>
+        public async IAsyncEnumerable<Update> SubscribeAsync(Topic request, [EnumeratorCancellation] CancellationToken cancellationToken = default)
+        {
+            CheckPayloadSize("Feed.Subscribe", request.CalculateSize());
+            await foreach (var response in _rpcClient.CallServerStreamingMethod<Update>("Feed.Subscribe", request, cancellationToken).WithCancellation(cancellationToken))
+            {
+                yield return response;
+            }
+        }
+        
+    }
+}
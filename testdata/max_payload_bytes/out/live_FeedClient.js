@@ -0,0 +1,78 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: FeedClient
+
+// Import encoding/decoding functions for each method
+import { encodeTopic, decodeUpdate } from './Feed.js';
+
+/**
+ * Fully-qualified proto name of Feed, for routing and logging
+ */
+export const FeedServiceName = "live.Feed";
+
+export class FeedClient {
+  /**
+   * Largest encoded request the client sends, in bytes. Larger requests reject with a RangeError.
+   */
+  static MAX_PAYLOAD_BYTES = 65536;
+
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Throws a RangeError for request bytes over MAX_PAYLOAD_BYTES
+   * @param {string} method
+   * @param {Uint8Array} reqBytes
+   */
+  checkPayloadSize(method, reqBytes) {
+    if (reqBytes.length > FeedClient.MAX_PAYLOAD_BYTES) {
+      throw new RangeError(`${method} request is ${reqBytes.length} bytes, over the limit of ${FeedClient.MAX_PAYLOAD_BYTES} bytes`);
+    }
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param {string} method - name of the streaming method: Subscribe
+   * @param {Object} requestObj - request object of the method
+   * @param {(response: Object) => void} callback - invoked with each decoded response
+   * @returns {() => void} function that cancels the subscription
+   */
+  subscribe(method, requestObj, callback) {
+    const codecs = {
+      Subscribe: [encodeTopic, decodeUpdate],
+    };
+    if (!Object.prototype.hasOwnProperty.call(codecs, method)) {
+      throw new Error(`Feed.${method} is not a server-streaming method`);
+    }
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    this.checkPayloadSize("Feed." + method, reqBytes);
+    return this.rpcClient.callServerStreamingMethod(
+      "Feed." + method,
+      reqBytes,
+      (respBytes) => callback(decode(respBytes))
+    );
+  }
+
+  
+  /**
+   * async Get
+   * Sends a Topic and returns an Update.
+   * @param { Topic } requestObj
+   * @returns {Promise< Update >}
+   */
+  async Get(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeTopic(requestObj);
+    this.checkPayloadSize("Feed.Get", reqBytes);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Feed.Get", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeUpdate(respBytes);
+    return respObj;
+  }
+  
+}
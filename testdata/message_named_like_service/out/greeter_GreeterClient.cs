@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Same
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<global::Other.Greeter> Get(global::Other.Greeter request);
+        
+    }
+
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "same.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a global::Other.Greeter and returns a global::Other.Greeter.
+        /// </summary>
+        public async UniTask<global::Other.Greeter> Get(global::Other.Greeter request)
+        {
+            var response = await _rpcClient.CallMethod<global::Other.Greeter>("Greeter.Get", request);
+            return response;
+        }
+        
+    }
+}
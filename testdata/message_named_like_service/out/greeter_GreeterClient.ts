@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeGreeter, decodeGreeter } from './Greeter';
+
+// Type definitions for request/response messages
+
+export interface Greeter {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "same.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Get method
+   * Sends a Greeter and returns a Greeter.
+   * @param requestObj - Greeter object
+   * @returns Promise resolving to Greeter
+   */
+  async Get(requestObj: Greeter): Promise<Greeter> {
+    // Encode request object to bytes
+    const reqBytes = encodeGreeter(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Greeter.Get", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeGreeter(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,62 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Same
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> Greeter(HelloRequest request);
+        
+        UniTask<HelloReply> GreeterClient_(HelloRequest request);
+        
+        UniTask<HelloReply> ServiceName_(HelloRequest request);
+        
+    }
+
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "same.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a HelloRequest and returns a HelloReply.
+        /// </summary>
+        public async UniTask<HelloReply> Greeter(HelloRequest request)
+        {
+            var response = await _rpcClient.CallMethod<HelloReply>("Greeter.Greeter", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a HelloRequest and returns a HelloReply.
+        /// </summary>
+        public async UniTask<HelloReply> GreeterClient_(HelloRequest request)
+        {
+            var response = await _rpcClient.CallMethod<HelloReply>("Greeter.GreeterClient", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a HelloRequest and returns a HelloReply.
+        /// </summary>
+        public async UniTask<HelloReply> ServiceName_(HelloRequest request)
+        {
+            var response = await _rpcClient.CallMethod<HelloReply>("Greeter.ServiceName", request);
+            return response;
+        }
+        
+    }
+}
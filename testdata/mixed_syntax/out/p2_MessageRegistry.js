@@ -0,0 +1,22 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Message factories of p2.proto by full proto name
+
+/**
+ * Functions creating a message object of p2.proto with its default field values, by full proto name
+ */
+export const P2MessageFactories = Object.freeze({
+  "mix.Legacy": () => ({
+    id: 0,
+    tags: [],
+  }),
+});
+
+/**
+ * A new message object of the type named fullName, undefined when the proto declares no such message
+ * @param {string} fullName e.g. "mix.Legacy"
+ * @returns {Object | undefined}
+ */
+export function createP2Message(fullName) {
+  const factory = Object.prototype.hasOwnProperty.call(P2MessageFactories, fullName) ? P2MessageFactories[fullName] : undefined;
+  return factory ? factory() : undefined;
+}
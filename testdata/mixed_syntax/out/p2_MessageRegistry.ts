@@ -0,0 +1,20 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Message factories of p2.proto by full proto name
+
+/**
+ * Functions creating a message object of p2.proto with its default field values, by full proto name
+ */
+export const P2MessageFactories: Readonly<Record<string, () => Record<string, unknown>>> = Object.freeze({
+  "mix.Legacy": () => ({
+    id: 0,
+    tags: [],
+  }),
+});
+
+/**
+ * A new message object of the type named fullName (e.g. "mix.Legacy"), undefined when the proto declares no such message
+ */
+export function createP2Message(fullName: string): Record<string, unknown> | undefined {
+  const factory = Object.prototype.hasOwnProperty.call(P2MessageFactories, fullName) ? P2MessageFactories[fullName] : undefined;
+  return factory ? factory() : undefined;
+}
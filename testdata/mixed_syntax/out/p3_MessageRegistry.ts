@@ -0,0 +1,20 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Message factories of p3.proto by full proto name
+
+/**
+ * Functions creating a message object of p3.proto with its default field values, by full proto name
+ */
+export const P3MessageFactories: Readonly<Record<string, () => Record<string, unknown>>> = Object.freeze({
+  "mix.Modern": () => ({
+    name: "",
+    id: 0,
+  }),
+});
+
+/**
+ * A new message object of the type named fullName (e.g. "mix.Modern"), undefined when the proto declares no such message
+ */
+export function createP3Message(fullName: string): Record<string, unknown> | undefined {
+  const factory = Object.prototype.hasOwnProperty.call(P3MessageFactories, fullName) ? P3MessageFactories[fullName] : undefined;
+  return factory ? factory() : undefined;
+}
@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: MixedClient
+
+// Import encoding/decoding functions for each method
+import { encodeModern, decodeLegacy } from './Mixed.js';
+
+/**
+ * Fully-qualified proto name of Mixed, for routing and logging
+ */
+export const MixedServiceName = "mix.Mixed";
+
+export class MixedClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Call
+   * Sends a Modern and returns a Legacy.
+   * @param { Modern } requestObj
+   * @returns {Promise< Legacy >}
+   */
+  async Call(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeModern(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Mixed.Call", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeLegacy(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,59 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: MixedClient
+
+// Import encoding/decoding functions for each method
+import { encodeModern, decodeLegacy } from './Mixed';
+
+// Type definitions for request/response messages
+
+export interface Modern {
+  [key: string]: any;
+}
+
+export interface Legacy {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Mixed, for routing and logging
+ */
+export const MixedServiceName = "mix.Mixed";
+
+/**
+ * Mixed RPC Client
+ * Provides type-safe methods to call Mixed on the server
+ */
+export class MixedClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Call method
+   * Sends a Modern and returns a Legacy.
+   * @param requestObj - Modern object
+   * @returns Promise resolving to Legacy
+   */
+  async Call(requestObj: Modern): Promise<Legacy> {
+    // Encode request object to bytes
+    const reqBytes = encodeModern(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Mixed.Call", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeLegacy(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,46 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Acme.Billing
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class InvoicesBase
+    {
+        
+        public abstract UniTask<Invoice> Get(Invoice request);
+        
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Invoices
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "acme.billing.Invoices";
+
+        public static ServiceDefinition BindService(InvoicesBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Invoices.Get"] = async (reqBytes) =>
+            {
+                var req = new Invoice();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.Get(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+
+            return def;
+        }
+    }
+}
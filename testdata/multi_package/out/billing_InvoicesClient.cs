@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Acme.Billing
+{
+    public interface IInvoicesClient
+    {
+        
+        UniTask<Invoice> Get(Invoice request);
+        
+    }
+
+    public class InvoicesClient : IInvoicesClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "acme.billing.Invoices";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public InvoicesClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends an Invoice and returns an Invoice.
+        /// </summary>
+        public async UniTask<Invoice> Get(Invoice request)
+        {
+            var response = await _rpcClient.CallMethod<Invoice>("Invoices.Get", request);
+            return response;
+        }
+        
+    }
+}
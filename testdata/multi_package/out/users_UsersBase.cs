@@ -0,0 +1,46 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Acme.Accounts
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class UsersBase
+    {
+        
+        public abstract UniTask<User> Get(User request);
+        
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Users
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "acme.users.Users";
+
+        public static ServiceDefinition BindService(UsersBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Users.Get"] = async (reqBytes) =>
+            {
+                var req = new User();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.Get(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+
+            return def;
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Acme.Accounts
+{
+    public interface IUsersClient
+    {
+        
+        UniTask<User> Get(User request);
+        
+    }
+
+    public class UsersClient : IUsersClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "acme.users.Users";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public UsersClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends an User and returns an User.
+        /// </summary>
+        public async UniTask<User> Get(User request)
+        {
+            var response = await _rpcClient.CallMethod<User>("Users.Get", request);
+            return response;
+        }
+        
+    }
+}
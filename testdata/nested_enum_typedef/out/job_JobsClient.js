@@ -0,0 +1,53 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: JobsClient
+
+// Import encoding/decoding functions for each method
+import { encodeQuery, decodeJob } from './Jobs.js';
+
+/**
+ * Fully-qualified proto name of Jobs, for routing and logging
+ */
+export const JobsServiceName = "job.Jobs";
+
+/**
+ * @typedef {Object} Query
+ * @property {string} [id]
+ */
+
+/**
+ * @typedef {Object} Job
+ * @property {string} [id]
+ * @property {State} [state]
+ */
+
+/**
+ * job.Job.State: PENDING = 0, DONE = 1
+ * @typedef {number} State
+ */
+
+export class JobsClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Get
+   * Sends a Query and returns a Job.
+   * @param { Query } requestObj
+   * @returns {Promise< Job >}
+   */
+  async Get(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeQuery(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Jobs.Get", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeJob(respBytes);
+    return respObj;
+  }
+  
+}
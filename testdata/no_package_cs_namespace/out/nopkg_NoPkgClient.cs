@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Acme.Api
+{
+    public interface INoPkgClient
+    {
+        
+        UniTask<Msg> Echo(Msg request);
+        
+    }
+
+    public class NoPkgClient : INoPkgClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "NoPkg";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public NoPkgClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Msg and returns a Msg.
+        /// </summary>
+        public async UniTask<Msg> Echo(Msg request)
+        {
+            var response = await _rpcClient.CallMethod<Msg>("NoPkg.Echo", request);
+            return response;
+        }
+        
+    }
+}
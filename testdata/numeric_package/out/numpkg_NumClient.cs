@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Api.V2Beta1.SubPkg3
+{
+    public interface INumClient
+    {
+        
+        UniTask<Req> Go(Req request);
+        
+    }
+
+    public class NumClient : INumClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "api.v2beta1.sub_pkg3.Num";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public NumClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Req and returns a Req.
+        /// </summary>
+        public async UniTask<Req> Go(Req request)
+        {
+            var response = await _rpcClient.CallMethod<Req>("Num.Go", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: NumClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeReq } from './Num.js';
+
+/**
+ * Fully-qualified proto name of Num, for routing and logging
+ */
+export const NumServiceName = "api.v2beta1.sub_pkg3.Num";
+
+export class NumClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Go
+   * Sends a Req and returns a Req.
+   * @param { Req } requestObj
+   * @returns {Promise< Req >}
+   */
+  async Go(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeReq(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Num.Go", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeReq(respBytes);
+    return respObj;
+  }
+  
+}
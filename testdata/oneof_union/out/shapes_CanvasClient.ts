@@ -0,0 +1,70 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: CanvasClient
+
+// Import encoding/decoding functions for each method
+import { encodeShape, decodeShape } from './Canvas';
+
+// Type definitions for request/response messages
+
+export interface Shape {
+  [key: string]: any;
+  kind?: ShapeKind;
+}
+
+// Discriminated unions for oneof fields
+
+export interface Circle {
+  [key: string]: any;
+}
+
+/**
+ * oneof kind of Shape, discriminated by $case
+ */
+export type ShapeKind =
+  | { $case: "circle"; circle: Circle }
+  | { $case: "label"; label: string }
+  | { $case: undefined };
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Canvas, for routing and logging
+ */
+export const CanvasServiceName = "shapes.Canvas";
+
+/**
+ * Canvas RPC Client
+ * Provides type-safe methods to call Canvas on the server
+ */
+export class CanvasClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Draw method
+   * Sends a Shape and returns a Shape.
+   * @param requestObj - Shape object
+   * @returns Promise resolving to Shape
+   */
+  async Draw(requestObj: Shape): Promise<Shape> {
+    // Encode request object to bytes
+    const reqBytes = encodeShape(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Canvas.Draw", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeShape(respBytes);
+    return respObj;
+  }
+  
+}
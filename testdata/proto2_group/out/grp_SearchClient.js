@@ -0,0 +1,48 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: SearchClient
+
+// Import encoding/decoding functions for each method
+import { encodeSearchRequest, decodeSearchResponse } from './Search.js';
+
+/**
+ * Fully-qualified proto name of Search, for routing and logging
+ */
+export const SearchServiceName = "legacy.Search";
+
+/**
+ * @typedef {Object} SearchRequest
+ * @property {string} [query]
+ */
+
+/**
+ * @typedef {Object} SearchResponse
+ * @property {Result[]} [result]
+ * @property {number} [total]
+ */
+
+export class SearchClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Find
+   * Sends a SearchRequest and returns a SearchResponse.
+   * @param { SearchRequest } requestObj
+   * @returns {Promise< SearchResponse >}
+   */
+  async Find(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeSearchRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Search.Find", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeSearchResponse(respBytes);
+    return respObj;
+  }
+  
+}
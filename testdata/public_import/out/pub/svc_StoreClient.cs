@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Pub.Svc
+{
+    public interface IStoreClient
+    {
+        
+        UniTask<global::Pub.Beta.Other> Get(global::Pub.Alpha.Item request);
+        
+    }
+
+    public class StoreClient : IStoreClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "pkg.svc.Store";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public StoreClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a global::Pub.Alpha.Item and returns a global::Pub.Beta.Other.
+        /// </summary>
+        public async UniTask<global::Pub.Beta.Other> Get(global::Pub.Alpha.Item request)
+        {
+            var response = await _rpcClient.CallMethod<global::Pub.Beta.Other>("Store.Get", request);
+            return response;
+        }
+        
+    }
+}
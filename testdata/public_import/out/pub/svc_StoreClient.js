@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: StoreClient
+
+// Import encoding/decoding functions for each method
+import { encodeItem, decodeOther } from './Store.js';
+
+/**
+ * Fully-qualified proto name of Store, for routing and logging
+ */
+export const StoreServiceName = "pkg.svc.Store";
+
+export class StoreClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Get
+   * Sends an Item and returns an Other.
+   * @param { Item } requestObj
+   * @returns {Promise< Other >}
+   */
+  async Get(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeItem(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Store.Get", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeOther(respBytes);
+    return respObj;
+  }
+  
+}
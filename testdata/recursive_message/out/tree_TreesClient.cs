@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Tree
+{
+    public interface ITreesClient
+    {
+        
+        UniTask<Node> Get(Node request);
+        
+    }
+
+    public class TreesClient : ITreesClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "tree.Trees";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public TreesClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Node and returns a Node.
+        /// </summary>
+        public async UniTask<Node> Get(Node request)
+        {
+            var response = await _rpcClient.CallMethod<Node>("Trees.Get", request);
+            return response;
+        }
+        
+    }
+}
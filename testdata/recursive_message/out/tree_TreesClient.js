@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: TreesClient
+
+// Import encoding/decoding functions for each method
+import { encodeNode, decodeNode } from './Trees.js';
+
+/**
+ * Fully-qualified proto name of Trees, for routing and logging
+ */
+export const TreesServiceName = "tree.Trees";
+
+export class TreesClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Get
+   * Sends a Node and returns a Node.
+   * @param { Node } requestObj
+   * @returns {Promise< Node >}
+   */
+  async Get(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeNode(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Trees.Get", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeNode(respBytes);
+    return respObj;
+  }
+  
+}
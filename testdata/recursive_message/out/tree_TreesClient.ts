@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: TreesClient
+
+// Import encoding/decoding functions for each method
+import { encodeNode, decodeNode } from './Trees';
+
+// Type definitions for request/response messages
+
+export interface Node {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Trees, for routing and logging
+ */
+export const TreesServiceName = "tree.Trees";
+
+/**
+ * Trees RPC Client
+ * Provides type-safe methods to call Trees on the server
+ */
+export class TreesClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Get method
+   * Sends a Node and returns a Node.
+   * @param requestObj - Node object
+   * @returns Promise resolving to Node
+   */
+  async Get(requestObj: Node): Promise<Node> {
+    // Encode request object to bytes
+    const reqBytes = encodeNode(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Trees.Get", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeNode(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,67 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+
+namespace Helloworld
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class GreeterBase
+    {
+        
+        public abstract UniTask<HelloReply> SayHello(HelloRequest request);
+        
+        public abstract UniTask<HelloReply> Update(HelloRequest request);
+        
+        /// <summary>
+        /// Called before each method marked with (webviewrpc.require_auth); throw to reject the call.
+        /// Rejects every call unless overridden.
+        /// </summary>
+        /// <param name="method">Full name of the method, e.g. "Greeter.SayHello".</param>
+        public virtual UniTask CheckAuth(string method)
+        {
+            throw new UnauthorizedAccessException($"{method} requires auth: override CheckAuth of GreeterBase");
+        }
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Greeter
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        public static ServiceDefinition BindService(GreeterBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Greeter.SayHello"] = async (reqBytes) =>
+            {
+                var req = new HelloRequest();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.SayHello(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+            def.MethodHandlers["Greeter.Update"] = async (reqBytes) =>
+            {
+                await impl.CheckAuth("Greeter.Update");
+                var req = new HelloRequest();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.Update(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+
+            return def;
+        }
+    }
+}
@@ -0,0 +1,78 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Server: GreeterServiceBase
+
+// 메서드별 인코딩 함수를 가져옴
+// Get encoding/decoding functions for each method
+import { decodeHelloRequest, encodeHelloReply } from './Greeter.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * 추상 클래스 (C#의 GreeterBase)
+ * 사용자(서버구현자)는 이 클래스를 상속해서 실제 로직을 override한다.
+ * Abstract class (like C#'s GreeterBase)
+ * Users (server implementors) should inherit this class and override the methods.
+ */
+export class GreeterBase {
+  
+  /**
+   * async SayHello
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    throw new Error("Method SayHello must be implemented");
+  }
+  
+  /**
+   * async Update
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async Update(requestObj) {
+    throw new Error("Method Update must be implemented");
+  }
+  
+  /**
+   * Called before each method marked with (webviewrpc.require_auth); throw (or reject) to refuse the call.
+   * Refuses every call unless overridden.
+   * @param {string} method full name of the method, e.g. "Greeter.SayHello"
+   * @returns {Promise<void>}
+   */
+  async checkAuth(method) {
+    throw new Error(`${method} requires auth: override checkAuth of GreeterBase`);
+  }
+}
+
+/**
+ * static BindService, (C#의 Greeter.BindService(impl))
+ * - impl: GreeterBase implementation
+ * - return: ServiceDefinition(methodHandlers)
+ */
+export class Greeter {
+  static bindService(impl) {
+    const def = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Greeter.SayHello"] = async (reqBytes) => {
+      const reqObj = decodeHelloRequest(reqBytes);
+      const respObj = await impl.SayHello(reqObj);
+      return encodeHelloReply(respObj);
+    };
+    
+    def.methodHandlers["Greeter.Update"] = async (reqBytes) => {
+      await impl.checkAuth("Greeter.Update");
+      const reqObj = decodeHelloRequest(reqBytes);
+      const respObj = await impl.Update(reqObj);
+      return encodeHelloReply(respObj);
+    };
+    
+
+    return def;
+  }
+}
@@ -0,0 +1,88 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Server: GreeterServiceBase
+
+// Import encoding/decoding functions for each method
+import { decodeHelloRequest, encodeHelloReply } from './Greeter';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * Service definition structure
+ */
+export interface ServiceDefinition {
+  methodHandlers: {
+    [key: string]: (reqBytes: Uint8Array) => Promise<Uint8Array>;
+  };
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Abstract class for Greeter server implementation
+ * Users (server implementors) should inherit this class and implement the methods.
+ */
+export abstract class GreeterBase {
+  
+  /**
+   * SayHello method
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  abstract SayHello(requestObj: HelloRequest): Promise<HelloReply>;
+  
+  /**
+   * Update method
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  abstract Update(requestObj: HelloRequest): Promise<HelloReply>;
+  
+  /**
+   * Called before each method marked with (webviewrpc.require_auth); reject to refuse the call.
+   * Refuses every call unless overridden.
+   * @param method - full name of the method, e.g. "Greeter.SayHello"
+   */
+  async checkAuth(method: string): Promise<void> {
+    throw new Error(`${method} requires auth: override checkAuth of GreeterBase`);
+  }
+}
+
+/**
+ * Service binding utility
+ * Binds a service implementation to create a ServiceDefinition
+ */
+export class Greeter {
+  static bindService(impl: GreeterBase): ServiceDefinition {
+    const def: ServiceDefinition = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Greeter.SayHello"] = async (reqBytes: Uint8Array): Promise<Uint8Array> => {
+      const reqObj = decodeHelloRequest(reqBytes);
+      const respObj = await impl.SayHello(reqObj);
+      return encodeHelloReply(respObj);
+    };
+    
+    def.methodHandlers["Greeter.Update"] = async (reqBytes: Uint8Array): Promise<Uint8Array> => {
+      await impl.checkAuth("Greeter.Update");
+      const reqObj = decodeHelloRequest(reqBytes);
+      const respObj = await impl.Update(reqObj);
+      return encodeHelloReply(respObj);
+    };
+    
+
+    return def;
+  }
+}
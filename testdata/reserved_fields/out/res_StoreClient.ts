@@ -0,0 +1,66 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: StoreClient
+
+// Import encoding/decoding functions for each method
+import { encodeEntry, decodeEntry } from './Store';
+
+// Type definitions for request/response messages
+
+export interface Entry {
+  [key: string]: any;
+  value?: EntryValue;
+}
+
+// Discriminated unions for oneof fields
+
+/**
+ * oneof value of Entry, discriminated by $case
+ */
+export type EntryValue =
+  | { $case: "text"; text: string }
+  | { $case: "number"; number: number }
+  | { $case: undefined };
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Store, for routing and logging
+ */
+export const StoreServiceName = "res.Store";
+
+/**
+ * Store RPC Client
+ * Provides type-safe methods to call Store on the server
+ */
+export class StoreClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Get method
+   * Sends an Entry and returns an Entry.
+   * @param requestObj - Entry object
+   * @returns Promise resolving to Entry
+   */
+  async Get(requestObj: Entry): Promise<Entry> {
+    // Encode request object to bytes
+    const reqBytes = encodeEntry(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Store.Get", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeEntry(respBytes);
+    return respObj;
+  }
+  
+}
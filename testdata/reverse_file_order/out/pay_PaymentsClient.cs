@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Acme.Pay
+{
+    public interface IPaymentsClient
+    {
+        
+        UniTask<Receipt> Charge(global::Acme.Common.Money request);
+        
+    }
+
+    public class PaymentsClient : IPaymentsClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "acme.pay.Payments";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public PaymentsClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a global::Acme.Common.Money and returns a Receipt.
+        /// </summary>
+        public async UniTask<Receipt> Charge(global::Acme.Common.Money request)
+        {
+            var response = await _rpcClient.CallMethod<Receipt>("Payments.Charge", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,46 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Profile
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class ProfilesBase
+    {
+        
+        public abstract UniTask<User> Update(User request);
+        
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Profiles
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "profile.Profiles";
+
+        public static ServiceDefinition BindService(ProfilesBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Profiles.Update"] = async (reqBytes) =>
+            {
+                var req = new User();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.Update(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+
+            return def;
+        }
+    }
+}
@@ -0,0 +1,53 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Server: ProfilesServiceBase
+
+// 메서드별 인코딩 함수를 가져옴
+// Get encoding/decoding functions for each method
+import { decodeUser, encodeUser } from './Profiles.js';
+
+/**
+ * Fully-qualified proto name of Profiles, for routing and logging
+ */
+export const ProfilesServiceName = "profile.Profiles";
+
+/**
+ * 추상 클래스 (C#의 ProfilesBase)
+ * 사용자(서버구현자)는 이 클래스를 상속해서 실제 로직을 override한다.
+ * Abstract class (like C#'s ProfilesBase)
+ * Users (server implementors) should inherit this class and override the methods.
+ */
+export class ProfilesBase {
+  
+  /**
+   * async Update
+   * @param { User } requestObj
+   * @returns {Promise< User >}
+   */
+  async Update(requestObj) {
+    throw new Error("Method Update must be implemented");
+  }
+  
+}
+
+/**
+ * static BindService, (C#의 Profiles.BindService(impl))
+ * - impl: ProfilesBase implementation
+ * - return: ServiceDefinition(methodHandlers)
+ */
+export class Profiles {
+  static bindService(impl) {
+    const def = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Profiles.Update"] = async (reqBytes) => {
+      const reqObj = decodeUser(reqBytes);
+      const respObj = await impl.Update(reqObj);
+      return encodeUser(respObj);
+    };
+    
+
+    return def;
+  }
+}
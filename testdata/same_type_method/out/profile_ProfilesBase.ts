@@ -0,0 +1,62 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Server: ProfilesServiceBase
+
+// Import encoding/decoding functions for each method
+import { decodeUser, encodeUser } from './Profiles';
+
+// Type definitions for request/response messages
+
+export interface User {
+  [key: string]: any;
+}
+
+/**
+ * Service definition structure
+ */
+export interface ServiceDefinition {
+  methodHandlers: {
+    [key: string]: (reqBytes: Uint8Array) => Promise<Uint8Array>;
+  };
+}
+
+/**
+ * Fully-qualified proto name of Profiles, for routing and logging
+ */
+export const ProfilesServiceName = "profile.Profiles";
+
+/**
+ * Abstract class for Profiles server implementation
+ * Users (server implementors) should inherit this class and implement the methods.
+ */
+export abstract class ProfilesBase {
+  
+  /**
+   * Update method
+   * @param requestObj - User object
+   * @returns Promise resolving to User
+   */
+  abstract Update(requestObj: User): Promise<User>;
+  
+}
+
+/**
+ * Service binding utility
+ * Binds a service implementation to create a ServiceDefinition
+ */
+export class Profiles {
+  static bindService(impl: ProfilesBase): ServiceDefinition {
+    const def: ServiceDefinition = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Profiles.Update"] = async (reqBytes: Uint8Array): Promise<Uint8Array> => {
+      const reqObj = decodeUser(reqBytes);
+      const respObj = await impl.Update(reqObj);
+      return encodeUser(respObj);
+    };
+    
+
+    return def;
+  }
+}
@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Profile
+{
+    public interface IProfilesClient
+    {
+        
+        UniTask<User> Update(User request);
+        
+    }
+
+    public class ProfilesClient : IProfilesClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "profile.Profiles";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public ProfilesClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends an User and returns an User.
+        /// </summary>
+        public async UniTask<User> Update(User request)
+        {
+            var response = await _rpcClient.CallMethod<User>("Profiles.Update", request);
+            return response;
+        }
+        
+    }
+}
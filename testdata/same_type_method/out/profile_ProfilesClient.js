@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: ProfilesClient
+
+// Import encoding/decoding functions for each method
+import { encodeUser, decodeUser } from './Profiles.js';
+
+/**
+ * Fully-qualified proto name of Profiles, for routing and logging
+ */
+export const ProfilesServiceName = "profile.Profiles";
+
+export class ProfilesClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Update
+   * Sends an User and returns an User.
+   * @param { User } requestObj
+   * @returns {Promise< User >}
+   */
+  async Update(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeUser(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Profiles.Update", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeUser(respBytes);
+    return respObj;
+  }
+  
+}
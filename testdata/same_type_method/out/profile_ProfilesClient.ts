@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: ProfilesClient
+
+// Import encoding/decoding functions for each method
+import { encodeUser, decodeUser } from './Profiles';
+
+// Type definitions for request/response messages
+
+export interface User {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Profiles, for routing and logging
+ */
+export const ProfilesServiceName = "profile.Profiles";
+
+/**
+ * Profiles RPC Client
+ * Provides type-safe methods to call Profiles on the server
+ */
+export class ProfilesClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Update method
+   * Sends an User and returns an User.
+   * @param requestObj - User object
+   * @returns Promise resolving to User
+   */
+  async Update(requestObj: User): Promise<User> {
+    // Encode request object to bytes
+    const reqBytes = encodeUser(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Profiles.Update", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeUser(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,61 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+
+namespace Helloworld
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class GreeterBase
+    {
+        
+        public abstract UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Greeter
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        /// <summary>
+        /// schema_version stamped in the response envelopes of the service.
+        /// </summary>
+        public const string SchemaVersion = "2.1";
+
+        public static ServiceDefinition BindService(GreeterBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Greeter.SayHello"] = async (reqBytes) =>
+            {
+                var envelope = RpcCallEnvelope.Decode(reqBytes.ToByteArray(), "Greeter", "SayHello");
+                try
+                {
+                    var req = new HelloRequest();
+                    req.MergeFrom(envelope.Payload);
+                    var resp = await impl.SayHello(req);
+                    return Google.Protobuf.ByteString.CopyFrom(new RpcCallEnvelope("Greeter", "SayHello", envelope.RequestId, resp.ToByteArray(), version: SchemaVersion).Encode());
+                }
+                catch (Exception e)
+                {
+                    // failures travel in the response envelope, see RpcCallEnvelope.Open
+                    return Google.Protobuf.ByteString.CopyFrom(RpcCallEnvelope.Error("Greeter", "SayHello", envelope.RequestId, e.Message, SchemaVersion).Encode());
+                }
+            };
+            
+
+            return def;
+        }
+    }
+}
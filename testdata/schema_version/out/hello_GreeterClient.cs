@@ -0,0 +1,62 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+
+namespace Helloworld
+{
+    public interface IGreeterClient
+    {
+        
+        UniTask<HelloReply> SayHello(HelloRequest request);
+        
+    }
+
+    /// <summary>
+    /// The greeter service.
+    /// </summary>
+    public class GreeterClient : IGreeterClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "helloworld.Greeter";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public GreeterClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        /// <summary>
+        /// schema_version of the client, sent in each request envelope and expected in each response envelope.
+        /// </summary>
+        public const string SchemaVersion = "2.1";
+
+        /// <summary>
+        /// Traces a warning when a response envelope was stamped with another schema version than SchemaVersion.
+        /// </summary>
+        private static void CheckSchemaVersion(RpcCallEnvelope envelope)
+        {
+            if (envelope.Version != SchemaVersion)
+            {
+                System.Diagnostics.Trace.TraceWarning($"{envelope.Service}.{envelope.Method} response has schema version \"{envelope.Version}\", expected \"{SchemaVersion}\"");
+            }
+        }
+
+        
+        /// <summary>
+        /// Sends a greeting.
+        /// </summary>
+        public async UniTask<HelloReply> SayHello(HelloRequest request)
+        {
+            var requestId = RpcCallEnvelope.NewRequestId();
+            var call = _rpcClient.CallMethodRaw("Greeter.SayHello", new RpcCallEnvelope("Greeter", "SayHello", requestId, request.ToByteArray(), version: SchemaVersion).Encode());
+            var response = HelloReply.Parser.ParseFrom(RpcCallEnvelope.Open(await call, "Greeter", "SayHello", requestId, CheckSchemaVersion));
+            return response;
+        }
+        
+    }
+}
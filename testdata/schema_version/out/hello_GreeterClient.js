@@ -0,0 +1,57 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { newRequestId, encodeEnvelope, openEnvelope } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * schema_version of the client, sent in each request envelope and expected in each response envelope.
+   */
+  static SCHEMA_VERSION = "2.1";
+
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Warns on the console when a response envelope was stamped with another schema version than SCHEMA_VERSION
+   * @param {import('./webviewrpc_runtime.js').RpcCallEnvelope} envelope
+   */
+  static checkSchemaVersion(envelope) {
+    if (envelope.version !== GreeterClient.SCHEMA_VERSION) {
+      console.warn(`${envelope.service}.${envelope.method} response has schema version "${envelope.version}", expected "${GreeterClient.SCHEMA_VERSION}"`);
+    }
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const requestId = newRequestId();
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", encodeEnvelope("Greeter", "SayHello", requestId, reqBytes, 0, undefined, GreeterClient.SCHEMA_VERSION));
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(openEnvelope(respBytes, "Greeter", "SayHello", requestId, GreeterClient.checkSchemaVersion));
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,76 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+import { RpcCallEnvelope, newRequestId, encodeEnvelope, openEnvelope } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  /**
+   * schema_version of the client, sent in each request envelope and expected in each response envelope.
+   */
+  static readonly SCHEMA_VERSION = "2.1";
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Warns on the console when a response envelope was stamped with another schema version than SCHEMA_VERSION
+   */
+  private static checkSchemaVersion(envelope: RpcCallEnvelope): void {
+    if (envelope.version !== GreeterClient.SCHEMA_VERSION) {
+      console.warn(`${envelope.service}.${envelope.method} response has schema version "${envelope.version}", expected "${GreeterClient.SCHEMA_VERSION}"`);
+    }
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    const requestId = newRequestId();
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", encodeEnvelope("Greeter", "SayHello", requestId, reqBytes, 0, undefined, GreeterClient.SCHEMA_VERSION));
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(openEnvelope(respBytes, "Greeter", "SayHello", requestId, GreeterClient.checkSchemaVersion));
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,46 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Shop
+{
+    /// <summary>
+    /// Override your own implementation of this class
+    /// </summary>
+    public abstract class CartBase
+    {
+        
+        public abstract UniTask<Item> Add(Item request);
+        
+    }
+
+    /// <summary>
+    /// Provides "BindService" method to bind your implementation to the generated service definition.
+    /// Works similar to gRPC's ServerServiceDefinition.BindService.
+    /// </summary>
+    public static class Cart
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "shop.Cart";
+
+        public static ServiceDefinition BindService(CartBase impl)
+        {
+            var def = new ServiceDefinition();
+
+            
+            def.MethodHandlers["Cart.Add"] = async (reqBytes) =>
+            {
+                var req = new Item();
+                req.MergeFrom(reqBytes);
+                var resp = await impl.Add(req);
+                return Google.Protobuf.ByteString.CopyFrom(resp.ToByteArray());
+            };
+            
+
+            return def;
+        }
+    }
+}
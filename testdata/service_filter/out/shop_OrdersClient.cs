@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Shop
+{
+    public interface IOrdersClient
+    {
+        
+        UniTask<Item> Place(Item request);
+        
+    }
+
+    public class OrdersClient : IOrdersClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "shop.Orders";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public OrdersClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends an Item and returns an Item.
+        /// </summary>
+        public async UniTask<Item> Place(Item request)
+        {
+            var response = await _rpcClient.CallMethod<Item>("Orders.Place", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,47 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System.Collections.Generic;
+using System.Runtime.CompilerServices;
+using System.Threading;
+
+namespace Ss
+{
+    public interface IBoardClient
+    {
+        
+        IAsyncEnumerable<Update> FollowAsync(Req request, CancellationToken cancellationToken = default);
+        
+    }
+
+    public class BoardClient : IBoardClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "ss.Board";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public BoardClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Req and returns an Update.
+        /// Server-streaming call, yields each response frame as it arrives.
+        /// Cancelling the token stops the stream.
+        /// </summary>
+        public async IAsyncEnumerable<Update> FollowAsync(Req request, [EnumeratorCancellation] CancellationToken cancellationToken = default)
+        {
+            await foreach (var response in _rpcClient.CallServerStreamingMethod<Update>("Board.Follow", request, cancellationToken).WithCancellation(cancellationToken))
+            {
+                yield return response;
+            }
+        }
+        
+    }
+}
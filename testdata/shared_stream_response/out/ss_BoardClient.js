@@ -0,0 +1,44 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: BoardClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeUpdate } from './Board.js';
+
+/**
+ * Fully-qualified proto name of Board, for routing and logging
+ */
+export const BoardServiceName = "ss.Board";
+
+export class BoardClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param {string} method - name of the streaming method: Follow
+   * @param {Object} requestObj - request object of the method
+   * @param {(response: Object) => void} callback - invoked with each decoded response
+   * @returns {() => void} function that cancels the subscription
+   */
+  subscribe(method, requestObj, callback) {
+    const codecs = {
+      Follow: [encodeReq, decodeUpdate],
+    };
+    if (!Object.prototype.hasOwnProperty.call(codecs, method)) {
+      throw new Error(`Board.${method} is not a server-streaming method`);
+    }
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    return this.rpcClient.callServerStreamingMethod(
+      "Board." + method,
+      reqBytes,
+      (respBytes) => callback(decode(respBytes))
+    );
+  }
+
+  
+}
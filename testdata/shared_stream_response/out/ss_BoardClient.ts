@@ -0,0 +1,85 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: BoardClient
+
+// Import encoding/decoding functions for each method
+import { encodeReq, decodeUpdate } from './Board';
+
+// Type definitions for request/response messages
+
+export interface Req {
+  [key: string]: any;
+}
+
+export interface Update {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+  callServerStreamingMethod(methodName: string, reqBytes: Uint8Array, onMessage: (respBytes: Uint8Array) => void): () => void;
+}
+
+/**
+ * Fully-qualified proto name of Board, for routing and logging
+ */
+export const BoardServiceName = "ss.Board";
+
+/**
+ * Server-streaming methods of Board mapped to the response type they emit
+ */
+export interface BoardStreamEventMap {
+  Follow: Update;
+}
+
+/**
+ * Server-streaming methods of Board mapped to their request type
+ */
+export interface BoardStreamRequestMap {
+  Follow: Req;
+}
+
+/**
+ * Board RPC Client
+ * Provides type-safe methods to call Board on the server
+ */
+export class BoardClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param method - name of the streaming method, see BoardStreamEventMap
+   * @param requestObj - request object of the method
+   * @param callback - invoked with each decoded response
+   * @returns function that cancels the subscription
+   */
+  subscribe<K extends keyof BoardStreamEventMap>(
+    method: K,
+    requestObj: BoardStreamRequestMap[K],
+    callback: (response: BoardStreamEventMap[K]) => void
+  ): () => void {
+    const codecs: {
+      [M in keyof BoardStreamEventMap]: [
+        (obj: BoardStreamRequestMap[M]) => Uint8Array,
+        (bytes: Uint8Array) => BoardStreamEventMap[M]
+      ];
+    } = {
+      Follow: [encodeReq, decodeUpdate],
+    };
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    return this.rpcClient.callServerStreamingMethod(
+      "Board." + method,
+      reqBytes,
+      (respBytes) => callback(decode(respBytes))
+    );
+  }
+
+  
+}
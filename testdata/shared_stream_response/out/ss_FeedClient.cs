@@ -0,0 +1,47 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System.Collections.Generic;
+using System.Runtime.CompilerServices;
+using System.Threading;
+
+namespace Ss
+{
+    public interface IFeedClient
+    {
+        
+        IAsyncEnumerable<Update> WatchAsync(Req request, CancellationToken cancellationToken = default);
+        
+    }
+
+    public class FeedClient : IFeedClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "ss.Feed";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public FeedClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Req and returns an Update.
+        /// Server-streaming call, yields each response frame as it arrives.
+        /// Cancelling the token stops the stream.
+        /// </summary>
+        public async IAsyncEnumerable<Update> WatchAsync(Req request, [EnumeratorCancellation] CancellationToken cancellationToken = default)
+        {
+            await foreach (var response in _rpcClient.CallServerStreamingMethod<Update>("Feed.Watch", request, cancellationToken).WithCancellation(cancellationToken))
+            {
+                yield return response;
+            }
+        }
+        
+    }
+}
@@ -0,0 +1,22 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// xUnit scaffold for CartClient: fill in the TODOs, then remove the Skip
+using Google.Protobuf;
+using Xunit;
+
+namespace Shop
+{
+    public class CartClientTests
+    {
+        [Fact(Skip = "TODO: sample request and canned response")]
+        public void Add_RoundTrips()
+        {
+            var request = new Item(); // TODO: set the fields of a sample request
+            Assert.Equal(request, Item.Parser.ParseFrom(request.ToByteArray()));
+
+            var response = new Item(); // TODO: set the fields of a canned response
+            Assert.Equal(response, Item.Parser.ParseFrom(response.ToByteArray()));
+
+            // TODO: call CartClient.Add through a WebViewRpcClient whose bridge answers "Cart.Add" with response
+        }
+    }
+}
@@ -0,0 +1,22 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// xUnit scaffold for OrdersClient: fill in the TODOs, then remove the Skip
+using Google.Protobuf;
+using Xunit;
+
+namespace Shop
+{
+    public class OrdersClientTests
+    {
+        [Fact(Skip = "TODO: sample request and canned response")]
+        public void Place_RoundTrips()
+        {
+            var request = new Item(); // TODO: set the fields of a sample request
+            Assert.Equal(request, Item.Parser.ParseFrom(request.ToByteArray()));
+
+            var response = new Item(); // TODO: set the fields of a canned response
+            Assert.Equal(response, Item.Parser.ParseFrom(response.ToByteArray()));
+
+            // TODO: call OrdersClient.Place through a WebViewRpcClient whose bridge answers "Orders.Place" with response
+        }
+    }
+}
@@ -0,0 +1,22 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using WebViewRPC;
+
+namespace Shop
+{
+    /// <summary>
+    /// Creates the generated clients of shop.proto.
+    /// Partial, so factories of other protos in this namespace extend the same class.
+    /// </summary>
+    public static partial class WebviewRpcClients
+    {
+        public static ICartClient CreateCart(WebViewRpcClient rpcClient)
+        {
+            return new CartClient(rpcClient);
+        }
+
+        public static IOrdersClient CreateOrders(WebViewRpcClient rpcClient)
+        {
+            return new OrdersClient(rpcClient);
+        }
+    }
+}
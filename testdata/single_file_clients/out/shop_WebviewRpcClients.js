@@ -0,0 +1,25 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript client factory for shop.proto
+
+import { CartClient } from './shop_webviewrpc.js';
+import { OrdersClient } from './shop_webviewrpc.js';
+
+/**
+ * Creates the generated clients of shop.proto
+ */
+export const WebviewRpcClients = {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   * @returns { CartClient }
+   */
+  createCart(rpcClient) {
+    return new CartClient(rpcClient);
+  },
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   * @returns { OrdersClient }
+   */
+  createOrders(rpcClient) {
+    return new OrdersClient(rpcClient);
+  },
+};
@@ -0,0 +1,19 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript client factory for shop.proto
+
+import { CartClient } from './shop_webviewrpc';
+import { OrdersClient } from './shop_webviewrpc';
+
+type RpcClientOf<T extends abstract new (rpcClient: any) => unknown> = ConstructorParameters<T>[0];
+
+/**
+ * Creates the generated clients of shop.proto
+ */
+export const WebviewRpcClients = {
+  createCart(rpcClient: RpcClientOf<typeof CartClient>): CartClient {
+    return new CartClient(rpcClient);
+  },
+  createOrders(rpcClient: RpcClientOf<typeof OrdersClient>): OrdersClient {
+    return new OrdersClient(rpcClient);
+  },
+};
@@ -0,0 +1,21 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript facade over every generated client of this run
+
+import { CartClient } from './shop_webviewrpc.js';
+import { OrdersClient } from './shop_webviewrpc.js';
+
+/**
+ * Every generated client of this run by service name, created over one shared transport
+ */
+export class WebviewRpc {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+    /** @type { CartClient } */
+    this.Cart = new CartClient(rpcClient);
+    /** @type { OrdersClient } */
+    this.Orders = new OrdersClient(rpcClient);
+  }
+}
@@ -0,0 +1,27 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript facade over every generated client of this run
+
+import { CartClient } from './shop_webviewrpc';
+import { OrdersClient } from './shop_webviewrpc';
+
+type RpcClientOf<T extends abstract new (rpcClient: any) => unknown> = ConstructorParameters<T>[0];
+
+/**
+ * Transport satisfying every generated client
+ */
+export type WebviewRpcTransport = RpcClientOf<typeof CartClient> & RpcClientOf<typeof OrdersClient>;
+
+/**
+ * Every generated client of this run by service name, created over one shared transport
+ */
+export class WebviewRpc {
+  readonly rpcClient: WebviewRpcTransport;
+  readonly Cart: CartClient;
+  readonly Orders: OrdersClient;
+
+  constructor(rpcClient: WebviewRpcTransport) {
+    this.rpcClient = rpcClient;
+    this.Cart = new CartClient(rpcClient);
+    this.Orders = new OrdersClient(rpcClient);
+  }
+}
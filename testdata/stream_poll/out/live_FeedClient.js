@@ -0,0 +1,65 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: FeedClient
+
+// Import encoding/decoding functions for each method
+import { encodeTopic, decodeUpdate } from './Feed.js';
+import { pollStream } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Feed, for routing and logging
+ */
+export const FeedServiceName = "live.Feed";
+
+export class FeedClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  /**
+   * Subscribe to a server-streaming method
+   * @param {string} method - name of the streaming method: Subscribe
+   * @param {Object} requestObj - request object of the method
+   * @param {(response: Object) => void} callback - invoked with each decoded response
+   * @param {(error: Error) => void} [onError] - invoked when a poll fails, which ends the subscription
+   * @returns {() => void} function that cancels the subscription
+   */
+  subscribe(method, requestObj, callback, onError = undefined) {
+    const codecs = {
+      Subscribe: [encodeTopic, decodeUpdate],
+    };
+    if (!Object.prototype.hasOwnProperty.call(codecs, method)) {
+      throw new Error(`Feed.${method} is not a server-streaming method`);
+    }
+    const [encode, decode] = codecs[method];
+    const reqBytes = encode(requestObj);
+    // stream_fallback=poll: pages of "<Method>$poll" every 1000 ms
+    return pollStream(
+      (pollBytes) => this.rpcClient.callMethod("Feed." + method + "$poll", pollBytes),
+      reqBytes,
+      1000,
+      (respBytes) => callback(decode(respBytes)),
+      onError
+    );
+  }
+
+  
+  /**
+   * async Get
+   * Sends a Topic and returns an Update.
+   * @param { Topic } requestObj
+   * @returns {Promise< Update >}
+   */
+  async Get(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeTopic(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Feed.Get", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeUpdate(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,105 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Encodes a poll of "<Service>.<Method>$poll", the stand-in for a
+ * server-streaming method with stream_fallback=poll: the cursor of the
+ * previous page (uint32 length + UTF-8, empty for the first poll) followed by
+ * the request of the method, little-endian.
+ * @param {string} cursor
+ * @param {Uint8Array} reqBytes
+ * @returns {Uint8Array}
+ */
+export function encodePollRequest(cursor, reqBytes) {
+  const cursorBytes = new TextEncoder().encode(cursor);
+  const out = new Uint8Array(4 + cursorBytes.length + reqBytes.length);
+  new DataView(out.buffer).setUint32(0, cursorBytes.length, true);
+  out.set(cursorBytes, 4);
+  out.set(reqBytes, 4 + cursorBytes.length);
+  return out;
+}
+
+/**
+ * Page answering a poll.
+ * @typedef {Object} RpcPollPage
+ * @property {boolean} done the stream ended with this page
+ * @property {string} cursor cursor of the next poll
+ * @property {Uint8Array[]} frames response frames produced since the previous poll
+ */
+
+/**
+ * Decodes the page answering a poll: a done byte (1 = the stream ended), the
+ * cursor of the next poll (uint32 length + UTF-8) and the response frames
+ * (each uint32 length + bytes), little-endian.
+ * @param {Uint8Array} bytes
+ * @returns {RpcPollPage}
+ */
+export function decodePollPage(bytes) {
+  const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+  if (bytes.length < 5) {
+    throw new Error("Truncated poll page");
+  }
+  const done = bytes[0] === 1;
+  let pos = 5 + view.getUint32(1, true);
+  if (pos > bytes.length) {
+    throw new Error("Truncated poll page");
+  }
+  const cursor = new TextDecoder().decode(bytes.subarray(5, pos));
+  const frames = [];
+  while (pos < bytes.length) {
+    if (pos + 4 > bytes.length) {
+      throw new Error("Truncated poll page");
+    }
+    const length = view.getUint32(pos, true);
+    pos += 4;
+    if (pos + length > bytes.length) {
+      throw new Error("Truncated poll page");
+    }
+    frames.push(bytes.subarray(pos, pos + length));
+    pos += length;
+  }
+  return { done, cursor, frames };
+}
+
+/**
+ * Stands in for a server-streaming call by polling: sends a poll, hands each
+ * frame of the page to onFrame and polls again after intervalMs, until a page
+ * is done, a poll fails or the returned function is called.
+ * @param {(pollBytes: Uint8Array) => Promise<Uint8Array>} call sends one poll
+ * @param {Uint8Array} reqBytes request of the streaming method
+ * @param {number} intervalMs
+ * @param {(respBytes: Uint8Array) => void} onFrame
+ * @param {(error: Error) => void} [onError] invoked with the failure ending the polling
+ * @returns {() => void} function that stops polling
+ */
+export function pollStream(call, reqBytes, intervalMs, onFrame, onError) {
+  let stopped = false;
+  let timer;
+  const poll = async (cursor) => {
+    try {
+      const page = decodePollPage(await call(encodePollRequest(cursor, reqBytes)));
+      for (const frame of page.frames) {
+        if (stopped) {
+          return;
+        }
+        onFrame(frame);
+      }
+      if (!page.done && !stopped) {
+        timer = setTimeout(() => poll(page.cursor), intervalMs);
+      }
+    } catch (e) {
+      if (stopped) {
+        return;
+      }
+      if (!onError) {
+        throw e;
+      }
+      onError(e);
+    }
+  };
+  poll("");
+  return () => {
+    stopped = true;
+    clearTimeout(timer);
+  };
+}
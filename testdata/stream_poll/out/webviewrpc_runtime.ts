@@ -0,0 +1,104 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Encodes a poll of "<Service>.<Method>$poll", the stand-in for a
+ * server-streaming method with stream_fallback=poll: the cursor of the
+ * previous page (uint32 length + UTF-8, empty for the first poll) followed by
+ * the request of the method, little-endian
+ */
+export function encodePollRequest(cursor: string, reqBytes: Uint8Array): Uint8Array {
+  const cursorBytes = new TextEncoder().encode(cursor);
+  const out = new Uint8Array(4 + cursorBytes.length + reqBytes.length);
+  new DataView(out.buffer).setUint32(0, cursorBytes.length, true);
+  out.set(cursorBytes, 4);
+  out.set(reqBytes, 4 + cursorBytes.length);
+  return out;
+}
+
+/**
+ * Page answering a poll
+ */
+export interface RpcPollPage {
+  /** the stream ended with this page */
+  done: boolean;
+  /** cursor of the next poll */
+  cursor: string;
+  /** response frames produced since the previous poll */
+  frames: Uint8Array[];
+}
+
+/**
+ * Decodes the page answering a poll: a done byte (1 = the stream ended), the
+ * cursor of the next poll (uint32 length + UTF-8) and the response frames
+ * (each uint32 length + bytes), little-endian
+ */
+export function decodePollPage(bytes: Uint8Array): RpcPollPage {
+  const view = new DataView(bytes.buffer, bytes.byteOffset, bytes.byteLength);
+  if (bytes.length < 5) {
+    throw new Error("Truncated poll page");
+  }
+  const done = bytes[0] === 1;
+  let pos = 5 + view.getUint32(1, true);
+  if (pos > bytes.length) {
+    throw new Error("Truncated poll page");
+  }
+  const cursor = new TextDecoder().decode(bytes.subarray(5, pos));
+  const frames: Uint8Array[] = [];
+  while (pos < bytes.length) {
+    if (pos + 4 > bytes.length) {
+      throw new Error("Truncated poll page");
+    }
+    const length = view.getUint32(pos, true);
+    pos += 4;
+    if (pos + length > bytes.length) {
+      throw new Error("Truncated poll page");
+    }
+    frames.push(bytes.subarray(pos, pos + length));
+    pos += length;
+  }
+  return { done, cursor, frames };
+}
+
+/**
+ * Stands in for a server-streaming call by polling: sends a poll through call,
+ * hands each frame of the page to onFrame and polls again after intervalMs,
+ * until a page is done, a poll fails (onError) or the returned function is called
+ */
+export function pollStream(
+  call: (pollBytes: Uint8Array) => Promise<Uint8Array>,
+  reqBytes: Uint8Array,
+  intervalMs: number,
+  onFrame: (respBytes: Uint8Array) => void,
+  onError?: (error: Error) => void
+): () => void {
+  let stopped = false;
+  let timer: ReturnType<typeof setTimeout> | undefined;
+  const poll = async (cursor: string): Promise<void> => {
+    try {
+      const page = decodePollPage(await call(encodePollRequest(cursor, reqBytes)));
+      for (const frame of page.frames) {
+        if (stopped) {
+          return;
+        }
+        onFrame(frame);
+      }
+      if (!page.done && !stopped) {
+        timer = setTimeout(() => poll(page.cursor), intervalMs);
+      }
+    } catch (e) {
+      if (stopped) {
+        return;
+      }
+      if (!onError) {
+        throw e;
+      }
+      onError(e as Error);
+    }
+  };
+  poll("");
+  return () => {
+    stopped = true;
+    clearTimeout(timer);
+  };
+}
@@ -0,0 +1,51 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Live
+{
+    public interface IFeedClient
+    {
+        
+        UniTask<Update> Get(Topic request);
+        
+        UniTask<Update> Subscribe(Topic request);
+        
+    }
+
+    public class FeedClient : IFeedClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "live.Feed";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public FeedClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Topic and returns an Update.
+        /// </summary>
+        public async UniTask<Update> Get(Topic request)
+        {
+            var response = await _rpcClient.CallMethod<Update>("Feed.Get", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a Topic and returns an Update.
+        /// </summary>
+        public async UniTask<Update> Subscribe(Topic request)
+        {
+            var response = await _rpcClient.CallMethod<Update>("Feed.Subscribe", request);
+            return response;
+        }
+        
+    }
+}
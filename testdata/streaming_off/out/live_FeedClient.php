@@ -0,0 +1,51 @@
+<?php
+// AUTO-GENERATED by protoc-gen-webviewrpc
+
+namespace Live;
+
+/**
+ * Feed RPC client. The transport passed to the constructor provides
+ * callMethod(string $method, string $requestBytes): string
+ */
+class FeedClient
+{
+    /**
+     * Fully-qualified proto name of the service, for routing and logging
+     */
+    public const SERVICE_NAME = 'live.Feed';
+
+    /**
+     * @var object
+     */
+    private $rpcClient;
+
+    /**
+     * @param object $rpcClient transport of the calls
+     */
+    public function __construct($rpcClient)
+    {
+        $this->rpcClient = $rpcClient;
+    }
+
+    /**
+     * Sends a Topic and returns an Update.
+     */
+    public function Get(Topic $request): Update
+    {
+        $respBytes = $this->rpcClient->callMethod('Feed.Get', $request->serializeToString());
+        $response = new Update();
+        $response->mergeFromString($respBytes);
+        return $response;
+    }
+
+    /**
+     * Sends a Topic and returns an Update.
+     */
+    public function Subscribe(Topic $request): Update
+    {
+        $respBytes = $this->rpcClient->callMethod('Feed.Subscribe', $request->serializeToString());
+        $response = new Update();
+        $response->mergeFromString($respBytes);
+        return $response;
+    }
+}
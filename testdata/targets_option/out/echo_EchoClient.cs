@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Echo
+{
+    public interface IEchoClient
+    {
+        
+        UniTask<Msg> Say(Msg request);
+        
+    }
+
+    public class EchoClient : IEchoClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "echo.Echo";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public EchoClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Msg and returns a Msg.
+        /// </summary>
+        public async UniTask<Msg> Say(Msg request)
+        {
+            var response = await _rpcClient.CallMethod<Msg>("Echo.Say", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,53 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Server: EchoServiceBase
+
+// 메서드별 인코딩 함수를 가져옴
+// Get encoding/decoding functions for each method
+import { decodeMsg, encodeMsg } from './Echo.js';
+
+/**
+ * Fully-qualified proto name of Echo, for routing and logging
+ */
+export const EchoServiceName = "echo.Echo";
+
+/**
+ * 추상 클래스 (C#의 EchoBase)
+ * 사용자(서버구현자)는 이 클래스를 상속해서 실제 로직을 override한다.
+ * Abstract class (like C#'s EchoBase)
+ * Users (server implementors) should inherit this class and override the methods.
+ */
+export class EchoBase {
+  
+  /**
+   * async Say
+   * @param { Msg } requestObj
+   * @returns {Promise< Msg >}
+   */
+  async Say(requestObj) {
+    throw new Error("Method Say must be implemented");
+  }
+  
+}
+
+/**
+ * static BindService, (C#의 Echo.BindService(impl))
+ * - impl: EchoBase implementation
+ * - return: ServiceDefinition(methodHandlers)
+ */
+export class Echo {
+  static bindService(impl) {
+    const def = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Echo.Say"] = async (reqBytes) => {
+      const reqObj = decodeMsg(reqBytes);
+      const respObj = await impl.Say(reqObj);
+      return encodeMsg(respObj);
+    };
+    
+
+    return def;
+  }
+}
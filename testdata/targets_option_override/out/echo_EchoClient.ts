@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: EchoClient
+
+// Import encoding/decoding functions for each method
+import { encodeMsg, decodeMsg } from './Echo';
+
+// Type definitions for request/response messages
+
+export interface Msg {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Echo, for routing and logging
+ */
+export const EchoServiceName = "echo.Echo";
+
+/**
+ * Echo RPC Client
+ * Provides type-safe methods to call Echo on the server
+ */
+export class EchoClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Say method
+   * Sends a Msg and returns a Msg.
+   * @param requestObj - Msg object
+   * @returns Promise resolving to Msg
+   */
+  async Say(requestObj: Msg): Promise<Msg> {
+    // Encode request object to bytes
+    const reqBytes = encodeMsg(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Echo.Say", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeMsg(respBytes);
+    return respObj;
+  }
+  
+}
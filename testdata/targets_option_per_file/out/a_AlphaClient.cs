@@ -0,0 +1,40 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Pertarget
+{
+    public interface IAlphaClient
+    {
+        
+        UniTask<PingA> Ping(PingA request);
+        
+    }
+
+    public class AlphaClient : IAlphaClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "pertarget.Alpha";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public AlphaClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a PingA and returns a PingA.
+        /// </summary>
+        public async UniTask<PingA> Ping(PingA request)
+        {
+            var response = await _rpcClient.CallMethod<PingA>("Alpha.Ping", request);
+            return response;
+        }
+        
+    }
+}
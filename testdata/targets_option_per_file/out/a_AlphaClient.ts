@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: AlphaClient
+
+// Import encoding/decoding functions for each method
+import { encodePingA, decodePingA } from './Alpha';
+
+// Type definitions for request/response messages
+
+export interface PingA {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Alpha, for routing and logging
+ */
+export const AlphaServiceName = "pertarget.Alpha";
+
+/**
+ * Alpha RPC Client
+ * Provides type-safe methods to call Alpha on the server
+ */
+export class AlphaClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Ping method
+   * Sends a PingA and returns a PingA.
+   * @param requestObj - PingA object
+   * @returns Promise resolving to PingA
+   */
+  async Ping(requestObj: PingA): Promise<PingA> {
+    // Encode request object to bytes
+    const reqBytes = encodePingA(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Alpha.Ping", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodePingA(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,53 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Server: BetaServiceBase
+
+// 메서드별 인코딩 함수를 가져옴
+// Get encoding/decoding functions for each method
+import { decodePingB, encodePingB } from './Beta.js';
+
+/**
+ * Fully-qualified proto name of Beta, for routing and logging
+ */
+export const BetaServiceName = "pertarget.Beta";
+
+/**
+ * 추상 클래스 (C#의 BetaBase)
+ * 사용자(서버구현자)는 이 클래스를 상속해서 실제 로직을 override한다.
+ * Abstract class (like C#'s BetaBase)
+ * Users (server implementors) should inherit this class and override the methods.
+ */
+export class BetaBase {
+  
+  /**
+   * async Ping
+   * @param { PingB } requestObj
+   * @returns {Promise< PingB >}
+   */
+  async Ping(requestObj) {
+    throw new Error("Method Ping must be implemented");
+  }
+  
+}
+
+/**
+ * static BindService, (C#의 Beta.BindService(impl))
+ * - impl: BetaBase implementation
+ * - return: ServiceDefinition(methodHandlers)
+ */
+export class Beta {
+  static bindService(impl) {
+    const def = {
+      methodHandlers: {}
+    };
+
+    
+    def.methodHandlers["Beta.Ping"] = async (reqBytes) => {
+      const reqObj = decodePingB(reqBytes);
+      const respObj = await impl.Ping(reqObj);
+      return encodePingB(respObj);
+    };
+    
+
+    return def;
+  }
+}
@@ -0,0 +1,55 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: BetaClient
+
+// Import encoding/decoding functions for each method
+import { encodePingB, decodePingB } from './Beta';
+
+// Type definitions for request/response messages
+
+export interface PingB {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Beta, for routing and logging
+ */
+export const BetaServiceName = "pertarget.Beta";
+
+/**
+ * Beta RPC Client
+ * Provides type-safe methods to call Beta on the server
+ */
+export class BetaClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Ping method
+   * Sends a PingB and returns a PingB.
+   * @param requestObj - PingB object
+   * @returns Promise resolving to PingB
+   */
+  async Ping(requestObj: PingB): Promise<PingB> {
+    // Encode request object to bytes
+    const reqBytes = encodePingB(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Beta.Ping", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodePingB(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,56 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GammaClient
+
+// Import encoding/decoding functions for each method
+import { encodePingC, decodePingC } from './Gamma';
+
+// Type definitions for request/response messages
+
+export interface PingC {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Gamma, for routing and logging
+ */
+export const GammaServiceName = "pertarget.Gamma";
+
+/**
+ * Gamma RPC Client
+ * Provides type-safe methods to call Gamma on the server
+ * no (webviewrpc.targets): only the parameters apply
+ */
+export class GammaClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Ping method
+   * Sends a PingC and returns a PingC.
+   * @param requestObj - PingC object
+   * @returns Promise resolving to PingC
+   */
+  async Ping(requestObj: PingC): Promise<PingC> {
+    // Encode request object to bytes
+    const reqBytes = encodePingC(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Gamma.Ping", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodePingC(respBytes);
+    return respObj;
+  }
+  
+}
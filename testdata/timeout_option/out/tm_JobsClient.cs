@@ -0,0 +1,58 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+using System;
+
+namespace Tm
+{
+    public interface IJobsClient
+    {
+        
+        UniTask<Job> Fast(Job request, int timeoutMs = 250);
+        
+        UniTask<Job> Slow(Job request);
+        
+    }
+
+    public class JobsClient : IJobsClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "tm.Jobs";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public JobsClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Job and returns a Job.
+        /// </summary>
+        /// <param name="timeoutMs">Call timeout, defaults to 250 ms. 0 disables it.</param>
+        public async UniTask<Job> Fast(Job request, int timeoutMs = 250)
+        {
+            var call = _rpcClient.CallMethod<Job>("Jobs.Fast", request);
+            if (timeoutMs > 0)
+            {
+                call = call.Timeout(TimeSpan.FromMilliseconds(timeoutMs));
+            }
+            var response = await call;
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends a Job and returns a Job.
+        /// </summary>
+        public async UniTask<Job> Slow(Job request)
+        {
+            var response = await _rpcClient.CallMethod<Job>("Jobs.Slow", request);
+            return response;
+        }
+        
+    }
+}
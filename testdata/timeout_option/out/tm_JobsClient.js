@@ -0,0 +1,57 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: JobsClient
+
+// Import encoding/decoding functions for each method
+import { encodeJob, decodeJob } from './Jobs.js';
+import { withTimeout } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Jobs, for routing and logging
+ */
+export const JobsServiceName = "tm.Jobs";
+
+export class JobsClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Fast
+   * Sends a Job and returns a Job.
+   * @param { Job } requestObj
+   * @param {number} [timeoutMs=250] call timeout, 0 disables it
+   * @returns {Promise< Job >}
+   */
+  async Fast(requestObj, timeoutMs = 250) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeJob(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    let call = this.rpcClient.callMethod("Jobs.Fast", reqBytes);
+    call = withTimeout(call, timeoutMs, "Jobs.Fast");
+    const respBytes = await call;
+    // 3) decode => responseObj
+    const respObj = decodeJob(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * async Slow
+   * Sends a Job and returns a Job.
+   * @param { Job } requestObj
+   * @returns {Promise< Job >}
+   */
+  async Slow(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeJob(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Jobs.Slow", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeJob(respBytes);
+    return respObj;
+  }
+  
+}
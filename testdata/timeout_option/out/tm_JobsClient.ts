@@ -0,0 +1,77 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: JobsClient
+
+// Import encoding/decoding functions for each method
+import { encodeJob, decodeJob } from './Jobs';
+import { withTimeout } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface Job {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+}
+
+/**
+ * Fully-qualified proto name of Jobs, for routing and logging
+ */
+export const JobsServiceName = "tm.Jobs";
+
+/**
+ * Jobs RPC Client
+ * Provides type-safe methods to call Jobs on the server
+ */
+export class JobsClient {
+  private rpcClient: WebViewRpcClient;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * Call Fast method
+   * Sends a Job and returns a Job.
+   * @param requestObj - Job object
+   * @param timeoutMs - call timeout, defaults to 250 ms, 0 disables it
+   * @returns Promise resolving to Job
+   */
+  async Fast(requestObj: Job, timeoutMs: number = 250): Promise<Job> {
+    // Encode request object to bytes
+    const reqBytes = encodeJob(requestObj);
+    
+    // Call remote method
+    let call = this.rpcClient.callMethod("Jobs.Fast", reqBytes);
+    call = withTimeout(call, timeoutMs, "Jobs.Fast");
+    const respBytes = await call;
+    
+    // Decode response bytes to object
+    const respObj = decodeJob(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * Call Slow method
+   * Sends a Job and returns a Job.
+   * @param requestObj - Job object
+   * @returns Promise resolving to Job
+   */
+  async Slow(requestObj: Job): Promise<Job> {
+    // Encode request object to bytes
+    const reqBytes = encodeJob(requestObj);
+    
+    // Call remote method
+    const respBytes = await this.rpcClient.callMethod("Jobs.Slow", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeJob(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,38 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Raised when a call does not complete within its timeout.
+ */
+export class RpcTimeoutError extends Error {
+  /**
+   * @param {string} method
+   * @param {number} timeoutMs
+   */
+  constructor(method, timeoutMs) {
+    super(`RPC call ${method} timed out after ${timeoutMs} ms`);
+    this.name = "RpcTimeoutError";
+    this.method = method;
+    this.timeoutMs = timeoutMs;
+  }
+}
+
+/**
+ * Rejects with RpcTimeoutError when call does not settle within timeoutMs.
+ * A timeoutMs of 0 or less disables the timeout.
+ * @template T
+ * @param {Promise<T>} call
+ * @param {number} timeoutMs
+ * @param {string} method
+ * @returns {Promise<T>}
+ */
+export function withTimeout(call, timeoutMs, method) {
+  if (!(timeoutMs > 0)) {
+    return call;
+  }
+  let timer;
+  const timeout = new Promise((_, reject) => {
+    timer = setTimeout(() => reject(new RpcTimeoutError(method, timeoutMs)), timeoutMs);
+  });
+  return Promise.race([call, timeout]).finally(() => clearTimeout(timer));
+}
@@ -0,0 +1,32 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/**
+ * Raised when a call does not complete within its timeout
+ */
+export class RpcTimeoutError extends Error {
+  readonly method: string;
+  readonly timeoutMs: number;
+
+  constructor(method: string, timeoutMs: number) {
+    super(`RPC call ${method} timed out after ${timeoutMs} ms`);
+    this.name = "RpcTimeoutError";
+    this.method = method;
+    this.timeoutMs = timeoutMs;
+  }
+}
+
+/**
+ * Rejects with RpcTimeoutError when call does not settle within timeoutMs.
+ * A timeoutMs of 0 or less disables the timeout.
+ */
+export function withTimeout<T>(call: Promise<T>, timeoutMs: number, method: string): Promise<T> {
+  if (!(timeoutMs > 0)) {
+    return call;
+  }
+  let timer: ReturnType<typeof setTimeout> | undefined;
+  const timeout = new Promise<never>((_, reject) => {
+    timer = setTimeout(() => reject(new RpcTimeoutError(method, timeoutMs)), timeoutMs);
+  });
+  return Promise.race([call, timeout]).finally(() => clearTimeout(timer));
+}
@@ -0,0 +1,51 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+using Cysharp.Threading.Tasks;
+using Google.Protobuf;
+using WebViewRPC;
+
+namespace Clock
+{
+    public interface IClockClient
+    {
+        
+        UniTask<Google.Protobuf.WellKnownTypes.Timestamp> Now(Google.Protobuf.WellKnownTypes.Empty request);
+        
+        UniTask<Google.Protobuf.WellKnownTypes.Timestamp> Schedule(Alarm request);
+        
+    }
+
+    public class ClockClient : IClockClient
+    {
+        /// <summary>
+        /// Fully-qualified proto name of the service, for routing and logging.
+        /// </summary>
+        public const string ServiceName = "clock.Clock";
+
+        private readonly WebViewRpcClient _rpcClient;
+
+        public ClockClient(WebViewRpcClient rpcClient)
+        {
+            this._rpcClient = rpcClient;
+        }
+
+        
+        /// <summary>
+        /// Sends a Google.Protobuf.WellKnownTypes.Empty and returns a Google.Protobuf.WellKnownTypes.Timestamp.
+        /// </summary>
+        public async UniTask<Google.Protobuf.WellKnownTypes.Timestamp> Now(Google.Protobuf.WellKnownTypes.Empty request)
+        {
+            var response = await _rpcClient.CallMethod<Google.Protobuf.WellKnownTypes.Timestamp>("Clock.Now", request);
+            return response;
+        }
+        
+        /// <summary>
+        /// Sends an Alarm and returns a Google.Protobuf.WellKnownTypes.Timestamp.
+        /// </summary>
+        public async UniTask<Google.Protobuf.WellKnownTypes.Timestamp> Schedule(Alarm request)
+        {
+            var response = await _rpcClient.CallMethod<Google.Protobuf.WellKnownTypes.Timestamp>("Clock.Schedule", request);
+            return response;
+        }
+        
+    }
+}
@@ -0,0 +1,53 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: ClockClient
+
+// Import encoding/decoding functions for each method
+import { encodeEmpty, decodeTimestamp, encodeAlarm } from './Clock.js';
+
+/**
+ * Fully-qualified proto name of Clock, for routing and logging
+ */
+export const ClockServiceName = "clock.Clock";
+
+export class ClockClient {
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+  }
+
+  
+  /**
+   * async Now
+   * Sends an Empty and returns a Timestamp.
+   * @param { Empty } requestObj
+   * @returns {Promise< Timestamp >}
+   */
+  async Now(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeEmpty(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Clock.Now", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeTimestamp(respBytes);
+    return respObj;
+  }
+  
+  /**
+   * async Schedule
+   * Sends an Alarm and returns a Timestamp.
+   * @param { Alarm } requestObj
+   * @returns {Promise< Timestamp >}
+   */
+  async Schedule(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeAlarm(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    const respBytes = await this.rpcClient.callMethod("Clock.Schedule", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeTimestamp(respBytes);
+    return respObj;
+  }
+  
+}
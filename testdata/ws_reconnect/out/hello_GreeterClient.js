@@ -0,0 +1,80 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// JavaScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter.js';
+import { reconnectTransport } from './webviewrpc_runtime.js';
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * The greeter service.
+ */
+export class GreeterClient {
+  /**
+   * Attempts to reconnect a dropped WebSocket before calls fail.
+   */
+  static WS_RECONNECT_MAX = 5;
+
+  /**
+   * Wait before the first reconnection attempt, in milliseconds; doubled before each further one.
+   */
+  static WS_RECONNECT_BACKOFF_MS = 500;
+
+  /**
+   * @param {WebViewRpcClient} rpcClient
+   */
+  constructor(rpcClient) {
+    this.rpcClient = rpcClient;
+    /** @type {Promise<void> | null} reconnection of the dropped socket, null while connected */
+    this.reconnecting = null;
+    this.watchSocket();
+  }
+
+  /**
+   * Reconnects with rpcClient.reconnect() when the WebSocket of the transport
+   * (rpcClient.socket) closes with another code than 1000, normal closure.
+   * Transports without a WebSocket or reconnect(), such as the WebView bridge,
+   * are left alone.
+   */
+  watchSocket() {
+    const socket = this.rpcClient.socket;
+    if (!socket || typeof socket.addEventListener !== "function" || typeof this.rpcClient.reconnect !== "function") {
+      return;
+    }
+    socket.addEventListener("close", (event) => {
+      if (event.code === 1000) {
+        return;
+      }
+      this.reconnecting = reconnectTransport(socket, () => this.rpcClient.reconnect(), GreeterClient.WS_RECONNECT_MAX, GreeterClient.WS_RECONNECT_BACKOFF_MS).then(() => {
+        this.reconnecting = null;
+        this.watchSocket();
+      });
+      // once reconnecting gave up, the calls awaiting it reject
+      this.reconnecting.catch(() => {});
+    }, { once: true });
+  }
+
+  
+  /**
+   * async SayHello
+   * Sends a greeting.
+   * @param { HelloRequest } requestObj
+   * @returns {Promise< HelloReply >}
+   */
+  async SayHello(requestObj) {
+    // 1) encode requestObj => Uint8Array
+    const reqBytes = encodeHelloRequest(requestObj);
+    // 2) callMethod => Promise<Uint8Array>
+    // ws_reconnect: calls made while the socket reconnects wait for it
+    await this.reconnecting;
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqBytes);
+    // 3) decode => responseObj
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
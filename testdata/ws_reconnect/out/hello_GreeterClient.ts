@@ -0,0 +1,107 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// TypeScript Client: GreeterClient
+
+// Import encoding/decoding functions for each method
+import { encodeHelloRequest, decodeHelloReply } from './Greeter';
+import { reconnectTransport } from './webviewrpc_runtime';
+
+// Type definitions for request/response messages
+
+export interface HelloRequest {
+  [key: string]: any;
+}
+
+export interface HelloReply {
+  [key: string]: any;
+}
+
+/**
+ * RPC Client interface (from app-webview-rpc)
+ */
+interface WebViewRpcClient {
+  callMethod(methodName: string, reqBytes: Uint8Array): Promise<Uint8Array>;
+  /** WebSocket of the transport, if it has one */
+  socket?: { addEventListener(type: "close", listener: (event: { code: number }) => void, options?: { once?: boolean }): void };
+  /** replaces socket with a new one, resolving once it is open */
+  reconnect?(): Promise<void>;
+}
+
+/**
+ * Fully-qualified proto name of Greeter, for routing and logging
+ */
+export const GreeterServiceName = "helloworld.Greeter";
+
+/**
+ * Greeter RPC Client
+ * Provides type-safe methods to call Greeter on the server
+ * The greeter service.
+ */
+export class GreeterClient {
+  private rpcClient: WebViewRpcClient;
+
+  /**
+   * Attempts to reconnect a dropped WebSocket before calls fail.
+   */
+  static readonly WS_RECONNECT_MAX = 5;
+
+  /**
+   * Wait before the first reconnection attempt, in milliseconds; doubled before each further one.
+   */
+  static readonly WS_RECONNECT_BACKOFF_MS = 500;
+
+  /** reconnection of the dropped socket, null while connected */
+  private reconnecting: Promise<void> | null = null;
+
+  constructor(rpcClient: WebViewRpcClient) {
+    this.rpcClient = rpcClient;
+    this.watchSocket();
+  }
+
+  /**
+   * Reconnects with rpcClient.reconnect() when the WebSocket of the transport
+   * (rpcClient.socket) closes with another code than 1000, normal closure.
+   * Transports without a WebSocket or reconnect(), such as the WebView bridge,
+   * are left alone.
+   */
+  private watchSocket(): void {
+    const socket = this.rpcClient.socket;
+    const reconnect = this.rpcClient.reconnect?.bind(this.rpcClient);
+    if (!socket || !reconnect) {
+      return;
+    }
+    socket.addEventListener("close", (event) => {
+      if (event.code === 1000) {
+        return;
+      }
+      const reconnecting = reconnectTransport(socket, reconnect, GreeterClient.WS_RECONNECT_MAX, GreeterClient.WS_RECONNECT_BACKOFF_MS).then(() => {
+        this.reconnecting = null;
+        this.watchSocket();
+      });
+      // once reconnecting gave up, the calls awaiting it reject
+      reconnecting.catch(() => {});
+      this.reconnecting = reconnecting;
+    }, { once: true });
+  }
+
+  
+  /**
+   * Call SayHello method
+   * Sends a greeting.
+   * @param requestObj - HelloRequest object
+   * @returns Promise resolving to HelloReply
+   */
+  async SayHello(requestObj: HelloRequest): Promise<HelloReply> {
+    // Encode request object to bytes
+    const reqBytes = encodeHelloRequest(requestObj);
+    
+    // Call remote method
+    // ws_reconnect: calls made while the socket reconnects wait for it
+    await this.reconnecting;
+    const respBytes = await this.rpcClient.callMethod("Greeter.SayHello", reqBytes);
+    
+    // Decode response bytes to object
+    const respObj = decodeHelloReply(respBytes);
+    return respObj;
+  }
+  
+}
@@ -0,0 +1,37 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/** @type {WeakMap<Object, Promise<void>>} reconnection of each dropped socket */
+const reconnections = new WeakMap();
+
+/**
+ * Reconnects a transport after its WebSocket socket dropped, calling
+ * reconnect up to maxAttempts times and waiting backoffMs before
+ * the first attempt, twice as long before each further one. All clients of
+ * the transport share the reconnection of a socket.
+ * @param {Object} socket the socket that closed
+ * @param {() => Promise<void>} reconnect reconnect() of the transport
+ * @param {number} maxAttempts
+ * @param {number} backoffMs
+ * @returns {Promise<void>} rejects once every attempt failed
+ */
+export function reconnectTransport(socket, reconnect, maxAttempts, backoffMs) {
+  let reconnection = reconnections.get(socket);
+  if (!reconnection) {
+    reconnection = (async () => {
+      for (let attempt = 1; ; attempt++) {
+        await new Promise((resolve) => setTimeout(resolve, backoffMs * 2 ** (attempt - 1)));
+        try {
+          await reconnect();
+          return;
+        } catch (e) {
+          if (attempt >= maxAttempts) {
+            throw new Error(`WebSocket reconnection failed after ${attempt} attempts: ${e instanceof Error ? e.message : e}`);
+          }
+        }
+      }
+    })();
+    reconnections.set(socket, reconnection);
+  }
+  return reconnection;
+}
@@ -0,0 +1,33 @@
+// AUTO-GENERATED by protoc-gen-webviewrpc
+// Support code shared by the generated clients and servers
+
+/** reconnection of each dropped socket */
+const reconnections = new WeakMap<object, Promise<void>>();
+
+/**
+ * Reconnects a transport after its WebSocket socket dropped, calling
+ * reconnect up to maxAttempts times and waiting backoffMs before
+ * the first attempt, twice as long before each further one. All clients of
+ * the transport share the reconnection of a socket; the promise rejects once
+ * every attempt failed
+ */
+export function reconnectTransport(socket: object, reconnect: () => Promise<void>, maxAttempts: number, backoffMs: number): Promise<void> {
+  let reconnection = reconnections.get(socket);
+  if (!reconnection) {
+    reconnection = (async () => {
+      for (let attempt = 1; ; attempt++) {
+        await new Promise<void>((resolve) => setTimeout(resolve, backoffMs * 2 ** (attempt - 1)));
+        try {
+          await reconnect();
+          return;
+        } catch (e) {
+          if (attempt >= maxAttempts) {
+            throw new Error(`WebSocket reconnection failed after ${attempt} attempts: ${e instanceof Error ? e.message : String(e)}`);
+          }
+        }
+      }
+    })();
+    reconnections.set(socket, reconnection);
+  }
+  return reconnection;
+}